@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// asyncCheck represents a validation by an I/O-bound function that is postponed until the traversal is done.
+	asyncCheck struct {
+		// fieldErr is the error that is placed in the errors in advance. It is removed if the validation passes.
+		fieldErr *fieldError
+
+		// valid is the result of the validation.
+		valid bool
+
+		// done is a flag. If true, the validation has been run.
+		done bool
+
+		// duration is the time that the validation took.
+		duration time.Duration
+
+		// trace is the record of the field. The result is recorded to trace.Tags[traceIndex]. if nil, it is not recorded.
+		trace      *FieldTrace
+		traceIndex int
+	}
+)
+
+// defaultMaxAsyncWorkers is the default maximum number of the I/O-bound functions that run concurrently.
+const defaultMaxAsyncWorkers = 8
+
+// postpone appends the error of the I/O-bound function to errs in advance, and postpones the validation.
+// If ft is not nil, the validation is recorded to it.
+func (w *walker) postpone(errs Errors, ft *FieldTrace, fieldErr *fieldError) Errors {
+	c := asyncCheck{fieldErr: fieldErr}
+	if ft != nil {
+		ft.Tags = append(ft.Tags, TagTrace{Name: fieldErr.tag.name, Params: fieldErr.tag.params, Async: true})
+		c.trace, c.traceIndex = ft, len(ft.Tags)-1
+	}
+	w.async = append(w.async, c)
+	return append(errs, fieldErr)
+}
+
+// runAsync runs the postponed validations of w concurrently, and merges the results into err that is returned by the traversal.
+// The errors keep the order of the fields. It returns CanceledError if ctx is done before all validations are run.
+func (c *config) runAsync(ctx context.Context, w *walker, err error) error {
+	if len(w.async) == 0 {
+		return err
+	}
+	return c.runAsyncChecks(ctx, w, err)
+}
+
+// runAsyncChecks runs the postponed validations of w. see runAsync.
+func (c *config) runAsyncChecks(ctx context.Context, w *walker, err error) error {
+	errs, ok := err.(Errors)
+	if !ok {
+		// the traversal stops, e.g. exceeding the limits.
+		return err
+	}
+
+	workers := c.maxAsyncWorkers
+	if workers <= 0 || workers > len(w.async) {
+		workers = len(w.async)
+	}
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+loop:
+	for i := range w.async {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		wg.Add(1)
+		go func(check *asyncCheck) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if ctx.Err() != nil {
+				return
+			}
+			tag := check.fieldErr.tag
+			start := time.Now()
+			check.valid, check.fieldErr.err = tag.validateFn(ctx, check.fieldErr.field, FuncOption{TagParams: tag.params, c: c})
+			check.duration = time.Since(start)
+			check.done = true
+		}(&w.async[i])
+	}
+	wg.Wait()
+
+	passed := make(map[*fieldError]struct{}, len(w.async))
+	for _, check := range w.async {
+		if !check.done {
+			return &CanceledError{Field: check.fieldErr.field.Name(), Err: ctx.Err()}
+		}
+		if check.trace != nil {
+			t := newTagTrace(check.fieldErr.tag, check.valid, check.fieldErr.err, check.duration)
+			t.Async = true
+			check.trace.Tags[check.traceIndex] = t
+		}
+		if w.observer != nil {
+			w.observer.OnTag(ctx, check.fieldErr.field, check.fieldErr.tag, check.valid, check.fieldErr.err, check.duration)
+		}
+		if check.valid && check.fieldErr.err == nil {
+			passed[check.fieldErr] = struct{}{}
+			continue
+		}
+		if err := w.report(check.fieldErr.field); err != nil {
+			return err
+		}
+	}
+
+	var results Errors
+	for _, e := range errs {
+		if fe, ok := e.(*fieldError); ok {
+			if _, ok := passed[fe]; ok {
+				continue
+			}
+		}
+		results = append(results, e)
+	}
+	if len(results) > 0 {
+		return results
+	}
+	return nil
+}
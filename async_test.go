@@ -0,0 +1,128 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/utahta/go-validator"
+)
+
+// userStore is a stand-in for a database.
+type userStore struct {
+	mu      sync.Mutex
+	names   map[string]bool
+	calls   int32
+	running int32
+	max     int32
+}
+
+func (s *userStore) notTaken(ctx context.Context, f validator.Field, _ validator.FuncOption) (bool, error) {
+	atomic.AddInt32(&s.calls, 1)
+	n := atomic.AddInt32(&s.running, 1)
+	defer atomic.AddInt32(&s.running, -1)
+	for {
+		m := atomic.LoadInt32(&s.max)
+		if n <= m || atomic.CompareAndSwapInt32(&s.max, m, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(5 * time.Millisecond):
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.names[f.String()], nil
+}
+
+func TestWithAsyncFunc(t *testing.T) {
+	type (
+		User struct {
+			Name string `valid:"required,unique,alpha"`
+		}
+		Team struct {
+			Owner   User
+			Members []User
+		}
+	)
+
+	store := &userStore{names: map[string]bool{"alice": true, "carol": true}}
+	v := validator.New(validator.WithAsyncFunc("unique", store.notTaken), validator.WithMaxAsyncWorkers(3))
+
+	team := &Team{
+		Owner: User{Name: "alice"},
+		Members: []User{
+			{Name: "bob"}, {Name: ""}, {Name: "carol"}, {Name: "dave1"}, {Name: "erin"},
+			{Name: "frank"}, {Name: "grace"}, {Name: "heidi"}, {Name: "ivan"}, {Name: "judy"},
+		},
+	}
+	assertValidationError(t, "Owner.Name: 'alice' does validate as 'unique';"+
+		"Members[1].Name: '' does validate as 'required';"+
+		"Members[1].Name: '' does validate as 'alpha';"+
+		"Members[2].Name: 'carol' does validate as 'unique';"+
+		"Members[3].Name: 'dave1' does validate as 'alpha'", v.ValidateStruct(team))
+
+	// the empty and the invalid names are not queried.
+	if calls := atomic.LoadInt32(&store.calls); calls != 9 {
+		t.Errorf("want 9 calls, but got %d", calls)
+	}
+	if max := atomic.LoadInt32(&store.max); max < 2 || max > 3 {
+		t.Errorf("want 2 or 3 concurrent calls, but got %d", max)
+	}
+
+	if err := v.ValidateVar("bob", "unique"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+	assertValidationError(t, ": 'alice' does validate as 'unique'", v.ValidateVar("alice", "unique"))
+
+	// in the parameters of or, it runs synchronously.
+	if err := v.ValidateVar("alice", "or(unique|email)"); err == nil {
+		t.Error("want error, but got nil")
+	}
+	if err := v.ValidateVar("bob", "or(unique|email)"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+
+	// WithFunc replaces the I/O-bound function with a synchronous one.
+	v.Apply(validator.WithFunc("unique", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}))
+	if err := v.ValidateStruct(team); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
+
+func TestWithAsyncFunc_Canceled(t *testing.T) {
+	type User struct {
+		Name string `valid:"unique"`
+	}
+
+	store := &userStore{}
+	v := validator.New(validator.WithAsyncFunc("unique", store.notTaken), validator.WithMaxAsyncWorkers(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 12*time.Millisecond)
+	defer cancel()
+	users := make([]User, 100)
+	for i := range users {
+		users[i].Name = "gopher"
+	}
+
+	err := v.ValidateVarContext(ctx, users, ";")
+	var canceledErr *validator.CanceledError
+	if !errors.As(err, &canceledErr) {
+		t.Fatalf("want CanceledError, but got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want context.DeadlineExceeded, but got %v", err)
+	}
+	if calls := atomic.LoadInt32(&store.calls); calls >= 100 {
+		t.Errorf("want the validation stopped, but got %d calls", calls)
+	}
+}
@@ -276,3 +276,70 @@ func BenchmarkValidateStructComplexParallelFailure(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkValidateStructSliceSuccess(b *testing.B) {
+	benchmarkValidateStructSlice(b, New())
+}
+
+func BenchmarkValidateStructSliceParallelThresholdSuccess(b *testing.B) {
+	benchmarkValidateStructSlice(b, New(WithParallelThreshold(1000)))
+}
+
+func benchmarkValidateStructSlice(b *testing.B, v *Validator) {
+	type (
+		Item struct {
+			ID    string `valid:"required,alphanum"`
+			Email string `valid:"email"`
+		}
+		Batch struct {
+			Items []Item `valid:"required"`
+		}
+	)
+
+	s := &Batch{Items: make([]Item, 50000)}
+	for i := range s.Items {
+		s.Items[i] = Item{ID: "gopher", Email: "gopher@example.com"}
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if err := v.ValidateStruct(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidateStructNestedSuccess(b *testing.B) {
+	type (
+		Leaf struct {
+			Name string `valid:"required"`
+		}
+		Branch struct {
+			Leaf   Leaf
+			Leaves [4]Leaf
+		}
+		Tree struct {
+			Branches []Branch `valid:"required"`
+			Next     *Tree
+		}
+	)
+
+	var s *Tree
+	for i := 0; i < 8; i++ {
+		t := &Tree{Branches: make([]Branch, 4), Next: s}
+		for j := range t.Branches {
+			t.Branches[j].Leaf.Name = "gopher"
+			for k := range t.Branches[j].Leaves {
+				t.Branches[j].Leaves[k].Name = "gopher"
+			}
+		}
+		s = t
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if err := ValidateStruct(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Package load loads and type-checks the Go packages for the commands.
+//
+// The packages are listed by the go command, and their dependencies are imported from the export data that the go
+// command builds, so the packages of the other modules are resolved as in the build.
+package load
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	// Config configures the loading.
+	Config struct {
+		// Dir is the directory in which the go command runs. If empty, the current directory is used.
+		Dir string
+
+		// Tests is a flag. If true, the test files of the packages are also loaded.
+		Tests bool
+	}
+
+	// Package represents a type-checked package.
+	Package struct {
+		// Name is the package name.
+		Name string
+
+		// PkgPath is the import path of the package.
+		PkgPath string
+
+		// Dir is the directory of the package.
+		Dir string
+
+		// Fset is the file set of Files.
+		Fset *token.FileSet
+
+		// Files is the parsed files of the package.
+		Files []*ast.File
+
+		// Types is the type-checked package.
+		Types *types.Package
+
+		// TypesInfo is the type information of Files.
+		TypesInfo *types.Info
+
+		// TypeErrors is the type errors. The types that have the errors may be invalid.
+		TypeErrors []error
+	}
+
+	// listPackage is a package of `go list -json`.
+	listPackage struct {
+		Dir          string
+		ImportPath   string
+		Name         string
+		Export       string
+		GoFiles      []string
+		CgoFiles     []string
+		TestGoFiles  []string
+		XTestGoFiles []string
+		ImportMap    map[string]string
+		ForTest      string
+		DepOnly      bool
+		Error        *struct{ Err string }
+	}
+)
+
+// Load loads the packages of the patterns. The patterns are the same as the go command. e.g. ./...
+// The type errors of the packages are tolerated and recorded in Package.TypeErrors,
+// but it fails if a package cannot be listed or parsed.
+// If c.Tests is true, the test files are loaded as the package itself, and the external test files as the package
+// whose name has the _test suffix.
+func Load(c Config, patterns ...string) ([]*Package, error) {
+	list, err := goList(c, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := map[string]struct{}{}
+	for _, p := range list {
+		listed[p.ImportPath] = struct{}{}
+	}
+
+	exports := map[string]string{}
+	variants := map[string]*listPackage{}
+	var targets []*listPackage
+	for _, p := range list {
+		if p.Export != "" {
+			exports[p.ImportPath] = p.Export
+		}
+		switch {
+		case p.ForTest != "":
+			variants[p.ImportPath] = p
+		case !p.DepOnly && !isTestMain(p, listed):
+			targets = append(targets, p)
+		}
+	}
+
+	var pkgs []*Package
+	for _, p := range targets {
+		if p.Error != nil && len(p.GoFiles)+len(p.CgoFiles)+len(p.TestGoFiles)+len(p.XTestGoFiles) == 0 {
+			return nil, errors.New(p.Error.Err)
+		}
+
+		files := append(append([]string{}, p.GoFiles...), p.CgoFiles...)
+		importMap := p.ImportMap
+		if c.Tests && len(p.TestGoFiles) > 0 {
+			files = append(files, p.TestGoFiles...)
+			if v, ok := variants[p.ImportPath+" ["+p.ImportPath+".test]"]; ok {
+				importMap = v.ImportMap
+			}
+		}
+		if len(files) > 0 {
+			pkg, err := check(p.Dir, p.ImportPath, files, importMap, exports)
+			if err != nil {
+				return nil, err
+			}
+			pkgs = append(pkgs, pkg)
+		}
+
+		if c.Tests && len(p.XTestGoFiles) > 0 {
+			path := p.ImportPath + "_test"
+			var importMap map[string]string
+			if v, ok := variants[path+" ["+p.ImportPath+".test]"]; ok {
+				importMap = v.ImportMap
+			}
+			pkg, err := check(p.Dir, path, p.XTestGoFiles, importMap, exports)
+			if err != nil {
+				return nil, err
+			}
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	return pkgs, nil
+}
+
+// goList runs `go list` for the patterns, and returns the packages and their dependencies with the export data.
+func goList(c Config, patterns []string) ([]*listPackage, error) {
+	args := []string{"list", "-e", "-json", "-export", "-deps"}
+	if c.Tests {
+		args = append(args, "-test")
+	}
+	args = append(append(args, "--"), patterns...)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Dir = c.Dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go list: %v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	var list []*listPackage
+	dec := json.NewDecoder(&stdout)
+	for {
+		p := &listPackage{}
+		if err := dec.Decode(p); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("go list: %v", err)
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// isTestMain returns true if the package is the generated main package of the test binary of a listed package.
+func isTestMain(p *listPackage, listed map[string]struct{}) bool {
+	if p.Name != "main" || !strings.HasSuffix(p.ImportPath, ".test") {
+		return false
+	}
+	_, ok := listed[strings.TrimSuffix(p.ImportPath, ".test")]
+	return ok
+}
+
+// check parses and type-checks the files in dir as the package of the path.
+// The imports are mapped by importMap, and imported from the export data of exports.
+func check(dir, path string, names []string, importMap, exports map[string]string) (*Package, error) {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	pkg := &Package{
+		Name:    files[0].Name.Name,
+		PkgPath: path,
+		Dir:     dir,
+		Fset:    fset,
+		Files:   files,
+		TypesInfo: &types.Info{
+			Types: map[ast.Expr]types.TypeAndValue{},
+			Defs:  map[*ast.Ident]types.Object{},
+			Uses:  map[*ast.Ident]types.Object{},
+		},
+	}
+
+	gc := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(export)
+	})
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			if mapped, ok := importMap[path]; ok {
+				path = mapped
+			}
+			return gc.Import(path)
+		}),
+		Error: func(err error) {
+			pkg.TypeErrors = append(pkg.TypeErrors, err)
+		},
+	}
+	// the errors are recorded by conf.Error, and the types are checked as much as possible.
+	pkg.Types, _ = conf.Check(path, fset, files, pkg.TypesInfo)
+	return pkg, nil
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
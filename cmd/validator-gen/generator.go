@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strconv"
+	"text/template"
+)
+
+const header = "// Code generated by validator-gen. DO NOT EDIT.\n"
+
+var (
+	funcs = template.FuncMap{"quote": strconv.Quote}
+
+	codeTemplate = template.Must(template.New("code").Funcs(funcs).Parse(header + `
+package {{ .Name }}
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/utahta/go-validator"
+)
+{{ range .Types }}
+var validatorGenTags{{ .Name }} = validator.NewGeneratedTags(
+{{- range .Fields }}
+	{{ quote .RawTag }},
+{{- end }}
+)
+
+// Validate validates the struct using default validator by the generated fields and tags.
+func (s *{{ .Name }}) Validate(ctx context.Context) error {
+	return s.ValidateWith(ctx, validator.DefaultValidator())
+}
+
+// ValidateWith validates the struct using v by the generated fields and tags.
+func (s *{{ .Name }}) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	if s == nil {
+		return nil
+	}
+	g := validator.BeginGenerated(ctx, v, s)
+	root := validator.RootField(s)
+	return g.End(s.validateGenerated(g, &root))
+}
+
+func (s *{{ .Name }}) validateGenerated(g *validator.GeneratedWalk, parent *validator.Field) error {
+	ok, err := g.Enter(parent)
+	if !ok {
+		return err
+	}
+	defer g.Leave()
+	tags, err := g.LoadTags(validatorGenTags{{ .Name }})
+	if err != nil {
+		return err
+	}
+{{- range $i, $f := .Fields }}
+{{- if .Nested }}
+	if f, ok, err := g.ValidateFieldTags(parent, {{ quote .Name }}, reflect.ValueOf(&s.{{ .Name }}).Elem(), tags, {{ $i }}); err != nil {
+		return err
+	} else if ok {
+		if err := s.{{ .Name }}.validateGenerated(g, &f); err != nil {
+			return err
+		}
+	}
+{{- else if .Basic }}
+	if err := g.ValidateValue(parent, {{ quote .Name }}, reflect.ValueOf(&s.{{ .Name }}).Elem(), tags, {{ $i }}); err != nil {
+		return err
+	}
+{{- else }}
+	if err := g.ValidateField(parent, {{ quote .Name }}, reflect.ValueOf(&s.{{ .Name }}).Elem(), tags, {{ $i }}); err != nil {
+		return err
+	}
+{{- end }}
+{{- end }}
+	return nil
+}
+{{ end }}`))
+
+	testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(header + `
+package {{ .Name }}
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+{{ range .Types }}
+func Test{{ .Name }}_ValidateParity(t *testing.T) {
+	validatorGenParity(t, reflect.TypeOf({{ .Name }}{}))
+}
+{{ end }}
+// validatorGenParity asserts that the generated code and reflection return the identical results
+// for the zero value and the random values of the type.
+func validatorGenParity(t *testing.T, typ reflect.Type) {
+	t.Helper()
+
+	v := validator.DefaultValidator()
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		p := reflect.New(typ)
+		if i > 0 {
+			validatorGenRandom(p.Elem(), r, 0)
+		}
+		if err := v.CheckParity(context.Background(), p.Interface().(validator.Generated)); err != nil {
+			t.Errorf("%+v: %v", p.Elem().Interface(), err)
+		}
+	}
+}
+
+// validatorGenRandom sets a random value to each settable value.
+func validatorGenRandom(v reflect.Value, r *rand.Rand, depth int) {
+	if !v.CanSet() || depth > 4 || r.Intn(5) == 0 {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		samples := []string{"", "a", "abc", "123", "-1", "gopher@example.com", "https://example.com", "あいう", "The quick brown fox jumps over the lazy dog"}
+		v.SetString(samples[r.Intn(len(samples))])
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(r.Intn(128) - 8))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(uint64(r.Intn(128)))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(r.Float64()*256 - 8)
+	case reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		validatorGenRandom(p.Elem(), r, depth+1)
+		v.Set(p)
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), r.Intn(4), r.Intn(4)+4)
+		for i := 0; i < s.Len(); i++ {
+			validatorGenRandom(s.Index(i), r, depth+1)
+		}
+		v.Set(s)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validatorGenRandom(v.Index(i), r, depth+1)
+		}
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		for i := r.Intn(4); i > 0; i-- {
+			k := reflect.New(v.Type().Key()).Elem()
+			e := reflect.New(v.Type().Elem()).Elem()
+			validatorGenRandom(k, r, depth+1)
+			validatorGenRandom(e, r, depth+1)
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			validatorGenRandom(v.Field(i), r, depth+1)
+		}
+	}
+}
+`))
+)
+
+func generate(pkg *pkgInfo) ([]byte, error) {
+	return execute(codeTemplate, pkg)
+}
+
+func generateTest(pkg *pkgInfo) ([]byte, error) {
+	return execute(testTemplate, pkg)
+}
+
+func execute(tmpl *template.Template, pkg *pkgInfo) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, pkg); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,47 @@
+// Package example is an example of the code generated by validator-gen.
+package example
+
+import "time"
+
+//go:generate go run ../.. -test
+
+type (
+	// User is an example of the request struct.
+	User struct {
+		Name      string            `valid:"required,alphanum"`
+		Email     string            `valid:"required,email"`
+		Age       int               `valid:"optional,min(20),max(150)"`
+		Nick      *string           `valid:"optional,len(1|10)"`
+		URL       string            `valid:"optional,url"`
+		Score     float64           `valid:"min(0)"`
+		ID        string            `valid:"or(alpha|numeric)"`
+		Address   Address           `valid:"required"`
+		Billing   *Address          `valid:"optional"`
+		Addresses []Address         `valid:"max(3)"`
+		Tags      []string          `valid:"max(3);required,alpha"`
+		Labels    map[string]string `valid:"max(2);alpha"`
+		Meta      interface{}
+		Created   time.Time
+		Ignored   string `valid:"-"`
+		Company
+		private string
+	}
+
+	// Address is an example of the nested struct.
+	Address struct {
+		Country string `valid:"required,country"`
+		Zip     string `valid:"required,jp_zipcode"`
+	}
+
+	// Company is an example of the embedded struct.
+	Company struct {
+		CompanyName string `valid:"optional,max(32)"`
+	}
+
+	// Category is an example of the self-referential struct.
+	Category struct {
+		Name     string      `valid:"required"`
+		Parent   *Category   `valid:"optional"`
+		Children []*Category `valid:"max(8)"`
+	}
+)
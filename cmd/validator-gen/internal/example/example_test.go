@@ -0,0 +1,181 @@
+package example
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/utahta/go-validator"
+)
+
+func newUser() *User {
+	nick := "gopher"
+	return &User{
+		Name:      "gopher",
+		Email:     "gopher@example.com",
+		Age:       20,
+		Nick:      &nick,
+		ID:        "abc",
+		Address:   Address{Country: "JP", Zip: "100-0001"},
+		Addresses: []Address{{Country: "JP", Zip: "100-0001"}},
+		Tags:      []string{"a", "b"},
+	}
+}
+
+func TestUser_Validate(t *testing.T) {
+	if err := newUser().Validate(context.Background()); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	u := newUser()
+	u.Address.Zip = "100"
+	u.Billing = &Address{Country: "jp", Zip: "100-0001"}
+	const want = "Address.Zip: '100' does validate as 'jp_zipcode';Billing.Country: 'jp' does validate as 'country'"
+	if err := u.Validate(context.Background()); err == nil || err.Error() != want {
+		t.Errorf("want `%v`, but got `%v`", want, err)
+	}
+
+	var nilUser *User
+	if err := nilUser.Validate(context.Background()); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+}
+
+func BenchmarkValidateGenerated(b *testing.B) {
+	u := newUser()
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = u.Validate(ctx)
+	}
+}
+
+func BenchmarkValidateReflected(b *testing.B) {
+	u := newUser()
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = validator.ValidateStructContext(ctx, u)
+	}
+}
+
+func TestCategory_ValidateCycle(t *testing.T) {
+	a := &Category{Name: "a"}
+	b := &Category{Parent: a}
+	a.Parent = b
+	a.Children = []*Category{a, b}
+
+	v := validator.New()
+	const want = "Parent.Name: '' does validate as 'required';Children[1].Name: '' does validate as 'required'"
+	if err := v.CheckParity(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.ValidateWith(context.Background(), v); err == nil || err.Error() != want {
+		t.Errorf("want `%v`, but got `%v`", want, err)
+	}
+}
+
+func TestCategory_ValidateMaxDepth(t *testing.T) {
+	c := newCategories(8)
+
+	v := validator.New(validator.WithMaxDepth(4))
+	const want = "Parent.Parent.Parent.Parent: exceeded the max depth 4"
+	if err := c.ValidateWith(context.Background(), v); err == nil || err.Error() != want {
+		t.Errorf("want `%v`, but got `%v`", want, err)
+	}
+	if err := v.CheckParity(context.Background(), c); err != nil {
+		t.Error(err)
+	}
+}
+
+// newCategories returns the category that has n ancestors.
+func newCategories(n int) *Category {
+	c := &Category{Name: "root"}
+	for i := 0; i < n; i++ {
+		c = &Category{Name: "child", Parent: c}
+	}
+	return c
+}
+
+func BenchmarkValidateGeneratedNested(b *testing.B) {
+	c := newCategories(16)
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Validate(ctx)
+	}
+}
+
+func BenchmarkValidateReflectedNested(b *testing.B) {
+	c := newCategories(16)
+	ctx := context.Background()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = validator.ValidateStructContext(ctx, c)
+	}
+}
+
+// recorder is an observer that records the events.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) OnValidateStart(_ context.Context, s interface{}) {
+	r.add("start %T", s)
+}
+
+func (r *recorder) OnValidateEnd(_ context.Context, s interface{}, err error, _ time.Duration) {
+	r.add("end %T %v", s, err)
+}
+
+func (r *recorder) OnTag(_ context.Context, field validator.Field, tag validator.Tag, valid bool, err error, _ time.Duration) {
+	r.add("%s %s %v %v", field.Name(), tag, valid, err)
+}
+
+func TestUser_ValidateOptions(t *testing.T) {
+	notTaken := func(_ context.Context, f validator.Field, _ validator.FuncOption) (bool, error) {
+		return f.Value().String() != "taken", nil
+	}
+
+	testcases := []struct {
+		name string
+		opts []validator.Option
+	}{
+		{name: "max errors", opts: []validator.Option{validator.WithMaxErrors(2)}},
+		{name: "max elements", opts: []validator.Option{validator.WithMaxElements(2)}},
+		{name: "max depth", opts: []validator.Option{validator.WithMaxDepth(1)}},
+		{name: "async", opts: []validator.Option{validator.WithAsyncFunc("alpha", notTaken)}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newUser()
+			u.Name = "-"
+			u.Email = "-"
+			u.Address.Zip = "100"
+			u.Tags = []string{"taken", "a", "1"}
+
+			generated, reflected := &recorder{}, &recorder{}
+			gv := validator.New(append(tc.opts, validator.WithObserver(generated))...)
+			rv := validator.New(append(tc.opts, validator.WithObserver(reflected))...)
+			if err := u.ValidateWith(context.Background(), gv); err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			_ = rv.ValidateStruct(u)
+
+			if want, got := strings.Join(reflected.events, "\n"), strings.Join(generated.events, "\n"); want != got {
+				t.Errorf("want\n%s\nbut got\n%s", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by validator-gen. DO NOT EDIT.
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/utahta/go-validator"
+)
+
+var validatorGenTagsAddress = validator.NewGeneratedTags(
+	"required,country",
+	"required,jp_zipcode",
+)
+
+// Validate validates the struct using default validator by the generated fields and tags.
+func (s *Address) Validate(ctx context.Context) error {
+	return s.ValidateWith(ctx, validator.DefaultValidator())
+}
+
+// ValidateWith validates the struct using v by the generated fields and tags.
+func (s *Address) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	if s == nil {
+		return nil
+	}
+	g := validator.BeginGenerated(ctx, v, s)
+	root := validator.RootField(s)
+	return g.End(s.validateGenerated(g, &root))
+}
+
+func (s *Address) validateGenerated(g *validator.GeneratedWalk, parent *validator.Field) error {
+	ok, err := g.Enter(parent)
+	if !ok {
+		return err
+	}
+	defer g.Leave()
+	tags, err := g.LoadTags(validatorGenTagsAddress)
+	if err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Country", reflect.ValueOf(&s.Country).Elem(), tags, 0); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Zip", reflect.ValueOf(&s.Zip).Elem(), tags, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+var validatorGenTagsCategory = validator.NewGeneratedTags(
+	"required",
+	"optional",
+	"max(8)",
+)
+
+// Validate validates the struct using default validator by the generated fields and tags.
+func (s *Category) Validate(ctx context.Context) error {
+	return s.ValidateWith(ctx, validator.DefaultValidator())
+}
+
+// ValidateWith validates the struct using v by the generated fields and tags.
+func (s *Category) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	if s == nil {
+		return nil
+	}
+	g := validator.BeginGenerated(ctx, v, s)
+	root := validator.RootField(s)
+	return g.End(s.validateGenerated(g, &root))
+}
+
+func (s *Category) validateGenerated(g *validator.GeneratedWalk, parent *validator.Field) error {
+	ok, err := g.Enter(parent)
+	if !ok {
+		return err
+	}
+	defer g.Leave()
+	tags, err := g.LoadTags(validatorGenTagsCategory)
+	if err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Name", reflect.ValueOf(&s.Name).Elem(), tags, 0); err != nil {
+		return err
+	}
+	if f, ok, err := g.ValidateFieldTags(parent, "Parent", reflect.ValueOf(&s.Parent).Elem(), tags, 1); err != nil {
+		return err
+	} else if ok {
+		if err := s.Parent.validateGenerated(g, &f); err != nil {
+			return err
+		}
+	}
+	if err := g.ValidateField(parent, "Children", reflect.ValueOf(&s.Children).Elem(), tags, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+var validatorGenTagsCompany = validator.NewGeneratedTags(
+	"optional,max(32)",
+)
+
+// Validate validates the struct using default validator by the generated fields and tags.
+func (s *Company) Validate(ctx context.Context) error {
+	return s.ValidateWith(ctx, validator.DefaultValidator())
+}
+
+// ValidateWith validates the struct using v by the generated fields and tags.
+func (s *Company) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	if s == nil {
+		return nil
+	}
+	g := validator.BeginGenerated(ctx, v, s)
+	root := validator.RootField(s)
+	return g.End(s.validateGenerated(g, &root))
+}
+
+func (s *Company) validateGenerated(g *validator.GeneratedWalk, parent *validator.Field) error {
+	ok, err := g.Enter(parent)
+	if !ok {
+		return err
+	}
+	defer g.Leave()
+	tags, err := g.LoadTags(validatorGenTagsCompany)
+	if err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "CompanyName", reflect.ValueOf(&s.CompanyName).Elem(), tags, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+var validatorGenTagsUser = validator.NewGeneratedTags(
+	"required,alphanum",
+	"required,email",
+	"optional,min(20),max(150)",
+	"optional,len(1|10)",
+	"optional,url",
+	"min(0)",
+	"or(alpha|numeric)",
+	"required",
+	"optional",
+	"max(3)",
+	"max(3);required,alpha",
+	"max(2);alpha",
+	"",
+	"",
+	"",
+)
+
+// Validate validates the struct using default validator by the generated fields and tags.
+func (s *User) Validate(ctx context.Context) error {
+	return s.ValidateWith(ctx, validator.DefaultValidator())
+}
+
+// ValidateWith validates the struct using v by the generated fields and tags.
+func (s *User) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	if s == nil {
+		return nil
+	}
+	g := validator.BeginGenerated(ctx, v, s)
+	root := validator.RootField(s)
+	return g.End(s.validateGenerated(g, &root))
+}
+
+func (s *User) validateGenerated(g *validator.GeneratedWalk, parent *validator.Field) error {
+	ok, err := g.Enter(parent)
+	if !ok {
+		return err
+	}
+	defer g.Leave()
+	tags, err := g.LoadTags(validatorGenTagsUser)
+	if err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Name", reflect.ValueOf(&s.Name).Elem(), tags, 0); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Email", reflect.ValueOf(&s.Email).Elem(), tags, 1); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Age", reflect.ValueOf(&s.Age).Elem(), tags, 2); err != nil {
+		return err
+	}
+	if err := g.ValidateField(parent, "Nick", reflect.ValueOf(&s.Nick).Elem(), tags, 3); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "URL", reflect.ValueOf(&s.URL).Elem(), tags, 4); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "Score", reflect.ValueOf(&s.Score).Elem(), tags, 5); err != nil {
+		return err
+	}
+	if err := g.ValidateValue(parent, "ID", reflect.ValueOf(&s.ID).Elem(), tags, 6); err != nil {
+		return err
+	}
+	if f, ok, err := g.ValidateFieldTags(parent, "Address", reflect.ValueOf(&s.Address).Elem(), tags, 7); err != nil {
+		return err
+	} else if ok {
+		if err := s.Address.validateGenerated(g, &f); err != nil {
+			return err
+		}
+	}
+	if f, ok, err := g.ValidateFieldTags(parent, "Billing", reflect.ValueOf(&s.Billing).Elem(), tags, 8); err != nil {
+		return err
+	} else if ok {
+		if err := s.Billing.validateGenerated(g, &f); err != nil {
+			return err
+		}
+	}
+	if err := g.ValidateField(parent, "Addresses", reflect.ValueOf(&s.Addresses).Elem(), tags, 9); err != nil {
+		return err
+	}
+	if err := g.ValidateField(parent, "Tags", reflect.ValueOf(&s.Tags).Elem(), tags, 10); err != nil {
+		return err
+	}
+	if err := g.ValidateField(parent, "Labels", reflect.ValueOf(&s.Labels).Elem(), tags, 11); err != nil {
+		return err
+	}
+	if err := g.ValidateField(parent, "Meta", reflect.ValueOf(&s.Meta).Elem(), tags, 12); err != nil {
+		return err
+	}
+	if err := g.ValidateField(parent, "Created", reflect.ValueOf(&s.Created).Elem(), tags, 13); err != nil {
+		return err
+	}
+	if f, ok, err := g.ValidateFieldTags(parent, "Company", reflect.ValueOf(&s.Company).Elem(), tags, 14); err != nil {
+		return err
+	} else if ok {
+		if err := s.Company.validateGenerated(g, &f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
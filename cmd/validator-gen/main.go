@@ -0,0 +1,93 @@
+// Command validator-gen generates the methods that validate the struct types by the fields and the tags known at generation.
+//
+// For each struct type, it generates the following methods.
+//
+//	func (s *T) Validate(ctx context.Context) error
+//	func (s *T) ValidateWith(ctx context.Context, v *validator.Validator) error
+//
+// They call the same validating functions as validator.ValidateStruct and return the identical Errors.
+// The generated code lists the fields and embeds their tags, so the struct types are not inspected at run time,
+// and the nested struct types that are generated in the same package are validated by calling their generated code.
+// Note that it is not reflection-free: the validating functions take a reflect.Value, so each field is passed as
+// reflect.ValueOf(&s.Field).Elem(), and the other fields, e.g. slices, maps and the struct types of other packages,
+// are validated by the validator as ValidateStruct does. So it is only moderately faster than ValidateStruct.
+// The rules registered by Validator.Rules are not applied because the tags are embedded in the generated code.
+// The generated code shares one traversal across the fields and the nested structs as the validator does,
+// so the cycles are skipped, the limits are applied to the whole struct, the I/O-bound functions run concurrently,
+// and the observer set by WithObserver sees the same calls.
+//
+// Usage:
+//
+//	//go:generate validator-gen -type User,Address -test
+//
+// With -test, it also generates the test that asserts the parity between the generated code and reflection.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	var (
+		typeNames = flag.String("type", "", "comma-separated list of type names; default is the struct types that have the tag")
+		output    = flag.String("output", "validator_gen.go", "output file name")
+		tagKey    = flag.String("tag", "valid", "key in the struct field's tag")
+		withTest  = flag.Bool("test", false, "generate the parity test")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: validator-gen [flags] [directory]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	c := config{
+		dir:    dir,
+		output: *output,
+		tagKey: *tagKey,
+	}
+	if *typeNames != "" {
+		c.typeNames = strings.Split(*typeNames, ",")
+	}
+
+	if err := run(c, *withTest); err != nil {
+		fmt.Fprintf(os.Stderr, "validator-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(c config, withTest bool) error {
+	pkg, err := parsePackage(c)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(pkg)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(c.dir, c.output), src, 0644); err != nil {
+		return err
+	}
+
+	if !withTest {
+		return nil
+	}
+	testSrc, err := generateTest(pkg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(c.dir, testFileName(c.output)), testSrc, 0644)
+}
+
+func testFileName(output string) string {
+	return strings.TrimSuffix(output, ".go") + "_test.go"
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	c := config{
+		dir:    filepath.Join("internal", "example"),
+		output: "validator_gen.go",
+		tagKey: "valid",
+	}
+	pkg, err := parsePackage(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := generate(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, filepath.Join(c.dir, c.output), src)
+
+	testSrc, err := generateTest(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, filepath.Join(c.dir, testFileName(c.output)), testSrc)
+}
+
+func TestParsePackage(t *testing.T) {
+	c := config{
+		dir:       filepath.Join("internal", "example"),
+		typeNames: []string{"User"},
+		output:    "validator_gen.go",
+		tagKey:    "valid",
+	}
+	pkg, err := parsePackage(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, got := 1, len(pkg.Types); want != got {
+		t.Fatalf("want %v, but got %v", want, got)
+	}
+
+	want := map[string]fieldInfo{
+		"Name":    {Name: "Name", RawTag: "required,alphanum", Basic: true},
+		"Address": {Name: "Address", RawTag: "required"},
+		"Billing": {Name: "Billing", RawTag: "optional"},
+		"Meta":    {Name: "Meta"},
+		"Company": {Name: "Company"},
+	}
+	got := map[string]fieldInfo{}
+	for _, f := range pkg.Types[0].Fields {
+		got[f.Name] = f
+	}
+	for name, w := range want {
+		if g, ok := got[name]; !ok || g != w {
+			t.Errorf("want %+v, but got %+v", w, g)
+		}
+	}
+	for _, name := range []string{"Ignored", "private"} {
+		if _, ok := got[name]; ok {
+			t.Errorf("want %s skipped", name)
+		}
+	}
+}
+
+func TestParsePackage_Types(t *testing.T) {
+	c := config{
+		dir:       filepath.Join("testdata", "types"),
+		typeNames: []string{"User"},
+		output:    "validator_gen.go",
+		tagKey:    "valid",
+	}
+	pkg, err := parsePackage(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []fieldInfo{
+		{Name: "Name", RawTag: "required", Basic: true},
+		{Name: "Count"},
+		{Name: "Parent", Nested: true},
+		{Name: "Ref", Nested: true},
+	}
+	if got := pkg.Types[0].Fields; !reflect.DeepEqual(want, got) {
+		t.Errorf("want %+v, but got %+v", want, got)
+	}
+}
+
+func TestParsePackage_Invalid(t *testing.T) {
+	testcases := []struct {
+		name      string
+		c         config
+		wantError string
+	}{
+		{
+			name:      "unknown type",
+			c:         config{dir: filepath.Join("internal", "example"), typeNames: []string{"Unknown"}, tagKey: "valid"},
+			wantError: "struct type Unknown not found in " + filepath.Join("internal", "example"),
+		},
+		{
+			name:      "no tag",
+			c:         config{dir: filepath.Join("testdata", "notag"), tagKey: "valid"},
+			wantError: "no struct type has the valid tag in " + filepath.Join("testdata", "notag"),
+		},
+		{
+			name:      "conflict",
+			c:         config{dir: filepath.Join("testdata", "conflict"), tagKey: "valid"},
+			wantError: "type User already has method Validate",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parsePackage(tc.c)
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+		})
+	}
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	want, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("%s is outdated, run go generate", name)
+	}
+}
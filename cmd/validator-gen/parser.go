@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/utahta/go-validator/cmd/internal/load"
+)
+
+type (
+	config struct {
+		// dir is a package directory.
+		dir string
+
+		// typeNames is a list of type names. if empty, the struct types that have the tag are generated.
+		typeNames []string
+
+		// output is an output file name.
+		output string
+
+		// tagKey is the key in the struct field's tag.
+		tagKey string
+	}
+
+	pkgInfo struct {
+		Name  string
+		Types []*typeInfo
+	}
+
+	typeInfo struct {
+		Name   string
+		Fields []fieldInfo
+	}
+
+	fieldInfo struct {
+		Name   string
+		RawTag string
+
+		// Nested is a flag that the field is the generated struct type or the pointer to it.
+		Nested bool
+
+		// Basic is a flag that the field is the basic type or the named type of it, e.g. string and time.Duration.
+		// The value is validated as is, since it has neither elements nor a nested struct.
+		Basic bool
+	}
+)
+
+// methodNames represents the names of the generated methods.
+var methodNames = []string{"Validate", "ValidateWith", "validateGenerated"}
+
+func parsePackage(c config) (*pkgInfo, error) {
+	pkgs, err := load.Load(load.Config{Dir: c.dir}, ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("no package in %s", c.dir)
+	}
+	pkg := pkgs[0]
+
+	structs := map[string]*types.Named{}
+	var order []string
+	for _, f := range pkg.Files {
+		if isOutput(pkg.Fset, f.Pos(), c.output) {
+			continue
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				obj, ok := pkg.TypesInfo.Defs[spec.(*ast.TypeSpec).Name].(*types.TypeName)
+				if !ok || obj.IsAlias() {
+					continue
+				}
+				named, ok := obj.Type().(*types.Named)
+				if !ok || named.TypeParams().Len() > 0 {
+					continue
+				}
+				if _, ok := named.Underlying().(*types.Struct); !ok {
+					continue
+				}
+				structs[obj.Name()] = named
+				order = append(order, obj.Name())
+			}
+		}
+	}
+
+	names := c.typeNames
+	if len(names) == 0 {
+		for _, name := range order {
+			if hasTag(structs[name], c.tagKey) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no struct type has the %s tag in %s", c.tagKey, c.dir)
+		}
+	}
+	sort.Strings(names)
+
+	generated := map[*types.Named]struct{}{}
+	for _, name := range names {
+		named, ok := structs[name]
+		if !ok {
+			return nil, fmt.Errorf("struct type %s not found in %s", name, c.dir)
+		}
+		generated[named] = struct{}{}
+	}
+
+	if err := checkMethods(pkg.Fset, names, structs, c.output); err != nil {
+		return nil, err
+	}
+
+	info := &pkgInfo{Name: pkg.Name}
+	for _, name := range names {
+		t := &typeInfo{Name: name}
+		st := structs[name].Underlying().(*types.Struct)
+		for i := 0; i < st.NumFields(); i++ {
+			field := st.Field(i)
+			if !field.Exported() {
+				continue
+			}
+			rawTag := reflect.StructTag(st.Tag(i)).Get(c.tagKey)
+			if rawTag == "-" {
+				continue
+			}
+
+			nested := isGenerated(field.Type(), generated)
+			basic := isBasic(field.Type())
+			if rawTag == "" && basic {
+				// these types do not perform recursive process so let's skip validating.
+				continue
+			}
+			t.Fields = append(t.Fields, fieldInfo{Name: field.Name(), RawTag: rawTag, Nested: nested, Basic: basic})
+		}
+		info.Types = append(info.Types, t)
+	}
+	return info, nil
+}
+
+func hasTag(named *types.Named, tagKey string) bool {
+	st := named.Underlying().(*types.Struct)
+	for i := 0; i < st.NumFields(); i++ {
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup(tagKey); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isOutput returns true if pos is in the output file, that is replaced by the generated code.
+func isOutput(fset *token.FileSet, pos token.Pos, output string) bool {
+	return filepath.Base(fset.Position(pos).Filename) == output
+}
+
+// checkMethods returns an error if the generated types already have the generated methods outside the output file.
+func checkMethods(fset *token.FileSet, names []string, structs map[string]*types.Named, output string) error {
+	for _, name := range names {
+		named := structs[name]
+		for i := 0; i < named.NumMethods(); i++ {
+			m := named.Method(i)
+			if isOutput(fset, m.Pos(), output) {
+				continue
+			}
+			for _, methodName := range methodNames {
+				if m.Name() == methodName {
+					return fmt.Errorf("type %s already has method %s", name, methodName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isGenerated returns true if the type is the generated struct type or the pointer to it.
+// The types are compared by types.Identical, so the aliases of them are also resolved.
+func isGenerated(typ types.Type, generated map[*types.Named]struct{}) bool {
+	for named := range generated {
+		if types.Identical(typ, named) || types.Identical(typ, types.NewPointer(named)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBasic returns true if the underlying type of the type is the basic type that is not validated without the tag.
+func isBasic(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return false
+	}
+	switch basic.Kind() {
+	case types.String, types.Bool,
+		types.Int, types.Int8, types.Int16, types.Int32, types.Int64,
+		types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64, types.Uintptr,
+		types.Float32, types.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+package conflict
+
+type User struct {
+	Name string `valid:"required"`
+}
+
+func (u *User) Validate() error {
+	return nil
+}
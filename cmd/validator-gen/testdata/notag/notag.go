@@ -0,0 +1,5 @@
+package notag
+
+type User struct {
+	Name string
+}
@@ -0,0 +1,33 @@
+package types
+
+import "time"
+
+type (
+	// User has the fields whose types are not seen from the syntax.
+	User struct {
+		Name    string `valid:"required"`
+		Status  Status
+		Timeout time.Duration
+		Label   Label
+		Count   int
+		Parent  *Parent
+		Ref     Ref
+	}
+
+	// Status is the named type of string. It is not validated without the tag.
+	Status string
+
+	// Label is the alias of string. It is not validated without the tag.
+	Label = string
+
+	// Parent is the alias of User. It is validated as the nested struct.
+	Parent = User
+
+	// Ref is the alias of the pointer to User. It is validated as the nested struct.
+	Ref = *User
+
+	// int shadows the predeclared type. It is validated as the struct.
+	int struct {
+		Value float64 `valid:"min(0)"`
+	}
+)
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/utahta/go-validator"
+	"github.com/utahta/go-validator/cmd/internal/load"
+)
+
+type (
+	linter struct {
+		valid tagLinter
+		mod   tagLinter
+	}
+
+	// tagLinter lints the tags of a key in the struct field's tag.
+	tagLinter struct {
+		key string
+
+		// label is the label of the tags in the messages. e.g. tag and mod
+		label string
+
+		// v parses the tags. it has the functions of the tag names.
+		v *validator.Validator
+
+		// rules represents the rules of the built-in tags.
+		rules map[string]rule
+
+		// custom represents a set of custom tag names. their kinds and params are not checked.
+		custom map[string]struct{}
+	}
+
+	diagnostic struct {
+		pos     token.Position
+		message string
+	}
+)
+
+func newLinter(tagKey, modTagKey string, customNames, customModNames []string) *linter {
+	noop := func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}
+
+	l := &linter{
+		valid: tagLinter{key: tagKey, label: "tag", v: validator.New(), rules: rules, custom: map[string]struct{}{}},
+		mod:   tagLinter{key: modTagKey, label: "mod", rules: modRules, custom: map[string]struct{}{}},
+	}
+	for _, name := range customNames {
+		l.valid.custom[name] = struct{}{}
+		l.valid.v.Apply(validator.WithFunc(name, noop))
+	}
+
+	// the mod tags have the same syntax as the valid tags, so they are parsed as the valid tags of the mod names.
+	funcMap := validator.FuncMap{}
+	for name := range modRules {
+		funcMap[name] = noop
+	}
+	for _, name := range customModNames {
+		l.mod.custom[name] = struct{}{}
+		funcMap[name] = noop
+	}
+	l.mod.v = validator.New(validator.WithFuncMap(funcMap))
+	return l
+}
+
+// String returns a diagnostic in the form of file:line:column: message.
+func (d diagnostic) String() string {
+	return fmt.Sprintf("%s: %s", d.pos, d.message)
+}
+
+// lintPatterns lints the packages of the patterns. The patterns are the same as the go command. e.g. ./...
+// The packages are type-checked with their dependencies, including the test files.
+func (l *linter) lintPatterns(patterns []string) ([]diagnostic, error) {
+	pkgs, err := load.Load(load.Config{Tests: true}, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var diags []diagnostic
+	for _, pkg := range pkgs {
+		diags = append(diags, l.lintFiles(pkg)...)
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := diags[i].pos, diags[j].pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+	return diags, nil
+}
+
+// lintFiles lints the files of a package.
+// The type errors are tolerated, and the fields of the types that cannot be resolved are reported
+// because their kinds are not checked.
+func (l *linter) lintFiles(pkg *load.Package) []diagnostic {
+	var diags []diagnostic
+	for _, f := range pkg.Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				if field.Tag == nil {
+					continue
+				}
+				s, err := strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					continue
+				}
+
+				typ := pkg.TypesInfo.TypeOf(field.Type)
+				messages, linted := l.valid.lint(reflect.StructTag(s), typ)
+				ms, ok := l.mod.lint(reflect.StructTag(s), typ)
+				messages = append(messages, ms...)
+				if (linted || ok) && unresolved(typ) {
+					messages = append(messages, "kind checks skipped: the type cannot be resolved")
+				}
+
+				for _, message := range messages {
+					diags = append(diags, diagnostic{
+						pos:     relativePosition(pkg.Fset.Position(field.Tag.Pos())),
+						message: fmt.Sprintf("%s: %s", fieldName(field), message),
+					})
+				}
+			}
+			return true
+		})
+	}
+	return diags
+}
+
+// lint returns the problems of the tag of the key for the field type. It returns false if the field has no tag of the key.
+func (t *tagLinter) lint(tag reflect.StructTag, typ types.Type) ([]string, bool) {
+	if t.key == "" {
+		return nil, false
+	}
+	rawTag, ok := tag.Lookup(t.key)
+	if !ok || rawTag == "" || rawTag == "-" {
+		return nil, false
+	}
+	return t.lintTag(rawTag, typ), true
+}
+
+// lintTag returns the problems of the tag for the field type. typ may be nil if unknown.
+func (t *tagLinter) lintTag(rawTag string, typ types.Type) []string {
+	levels, err := t.v.ParseTag(rawTag)
+	if err != nil {
+		return []string{t.parseError(err)}
+	}
+
+	var messages []string
+	for _, tags := range levels {
+		k := kindOf(typ)
+		for _, tag := range tags {
+			messages = append(messages, t.lintTagKind(tag, k, typ)...)
+		}
+		typ = elemOf(typ)
+	}
+	return messages
+}
+
+func (t *tagLinter) lintTagKind(tag validator.Tag, k kind, typ types.Type) []string {
+	if _, ok := t.custom[tag.Name()]; ok {
+		return nil
+	}
+
+	r, ok := t.rules[tag.Name()]
+	if !ok {
+		return nil
+	}
+
+	if tag.Name() == "or" {
+		var messages []string
+		for _, rawTag := range tag.Params() {
+			levels, err := t.v.ParseTag(rawTag)
+			if err != nil {
+				messages = append(messages, t.parseError(err))
+				continue
+			}
+			for _, tags := range levels[:1] {
+				for _, tag := range tags {
+					messages = append(messages, t.lintTagKind(tag, k, typ)...)
+				}
+			}
+		}
+		return messages
+	}
+
+	if k != unknownKind && r.kinds&k == 0 && !acceptsType(tag.Name(), typ) {
+		return []string{fmt.Sprintf("%s %s cannot be applied to %s", t.label, tag, types.TypeString(typ, nil))}
+	}
+	if err := r.checkParams(tag.Params(), k); err != nil {
+		return []string{fmt.Sprintf("%s %s: %v", t.label, tag, err)}
+	}
+	return nil
+}
+
+// parseError returns the message of the parse error. The mod tags are parsed as the valid tags,
+// so the label is replaced to be the same message as the validator. e.g. parse: mod trimm function not found
+func (t *tagLinter) parseError(err error) string {
+	return strings.Replace(err.Error(), "parse: tag ", "parse: "+t.label+" ", 1)
+}
+
+// relativePosition returns the position whose file name is relative to the working directory if possible.
+func relativePosition(pos token.Position) token.Position {
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, pos.Filename); err == nil && !strings.HasPrefix(rel, "..") {
+			pos.Filename = rel
+		}
+	}
+	return pos
+}
+
+// fieldName returns the name of the field. The name of the embedded field is the type name.
+func fieldName(field *ast.Field) string {
+	if len(field.Names) > 0 {
+		names := make([]string, 0, len(field.Names))
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		return strings.Join(names, ", ")
+	}
+
+	expr := field.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+	return "?"
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestLinter_LintPatterns(t *testing.T) {
+	l := newLinter("valid", "mod", []string{"slug"}, nil)
+	diags, err := l.lintPatterns([]string{"./testdata/src/..."})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bad := filepath.Join("testdata", "src", "bad", "bad.go")
+	want := []string{
+		bad + ":9:30: Name: parse: tag requried function not found",
+		bad + ":10:30: Active: tag min(1) cannot be applied to bool",
+		bad + ":11:30: Age: tag max(abc): invalid param abc",
+		bad + ":12:30: Score: tag min(-1): invalid param -1",
+		bad + ":13:30: Nick: tag len(1|2|3): 1 to 2 params required, but got 3",
+		bad + ":14:30: Card: parse: tag creditcard: unknown card brand foo",
+		bad + ":15:30: Country: tag country(alpha4): unknown param alpha4",
+		bad + ":16:30: ID: parse: tag numbr function not found",
+		bad + ":17:30: Code: tag min(x): invalid param x",
+		bad + ":19:30: Labels: tag alpha cannot be applied to bool",
+		bad + ":20:30: Matrix: tag min(a): invalid param a",
+		bad + ":21:30: Created: tag email cannot be applied to time.Time",
+		bad + ":22:30: Email: tag email(x): no params required",
+		bad + ":24:30: Broken: parse: invalid literal in tag separator",
+		bad + ":28:30: External: kind checks skipped: the type cannot be resolved",
+		bad + ":29:30: Addr: tag ip cannot be applied to []byte",
+		bad + ":30:30: Site: tag url cannot be applied to time.Time",
+		bad + ":31:30: Title: parse: mod trimm function not found",
+		bad + ":32:30: Count: mod lower cannot be applied to int",
+		bad + ":33:30: Prefix: mod upper(x): no params required",
+		bad + ":39:15: Inline: tag alpha cannot be applied to bool",
+	}
+
+	var got []string
+	for _, d := range diags {
+		got = append(got, d.String())
+	}
+	if len(want) != len(got) {
+		t.Fatalf("want %d diagnostics, but got %d\n%v", len(want), len(got), got)
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Errorf("want `%v`, but got `%v`", want[i], got[i])
+		}
+	}
+}
+
+func TestLinter_CustomNames(t *testing.T) {
+	diags, err := newLinter("valid", "mod", nil, nil).lintPatterns([]string{"./testdata/src/good"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := filepath.Join("testdata", "src", "good", "good.go") + ":18:27: Custom: parse: tag slug function not found"
+	if len(diags) != 1 || diags[0].String() != want {
+		t.Errorf("want `%v`, but got %v", want, diags)
+	}
+}
+
+func TestLinter_LintPatterns_NotFound(t *testing.T) {
+	if _, err := newLinter("valid", "mod", nil, nil).lintPatterns([]string{"./testdata/notfound"}); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
+
+func TestLinter_ModTag(t *testing.T) {
+	tests := []struct {
+		name           string
+		modTagKey      string
+		customModNames []string
+		want           []string
+	}{
+		{"default", "mod", nil, []string{"Title", "Count", "Prefix"}},
+		{"custom mod names", "mod", []string{"trimm"}, []string{"Count", "Prefix"}},
+		{"no mod tag", "", nil, nil},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			diags, err := newLinter("valid", tc.modTagKey, nil, tc.customModNames).lintPatterns([]string{"./testdata/src/bad"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, d := range diags {
+				if strings.Contains(d.message, "mod") {
+					got = append(got, d.message[:strings.Index(d.message, ":")])
+				}
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("want %v, but got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestRules_Drift tests that the rules are the same as the built-in functions of the validator.
+func TestRules_Drift(t *testing.T) {
+	names := validator.TagNames()
+	if len(rules) != len(names) {
+		t.Errorf("want %d rules, but got %d", len(names), len(rules))
+	}
+	for _, name := range names {
+		if _, ok := rules[name]; !ok {
+			t.Errorf("%s has no rule", name)
+		}
+	}
+
+	// the built-in modifying functions are not exported, so they are read from the source.
+	filename := filepath.Join("..", "..", "mod.go")
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var modNames []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if ident, ok := lit.Type.(*ast.Ident); !ok || ident.Name != "ModFuncMap" {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.BasicLit); ok && key.Kind == token.STRING {
+				name, _ := strconv.Unquote(key.Value)
+				modNames = append(modNames, name)
+			}
+		}
+		return false
+	})
+
+	if len(modNames) == 0 {
+		t.Fatalf("%s: no ModFuncMap literal found", filename)
+	}
+	for _, name := range modNames {
+		if _, ok := modRules[name]; !ok {
+			t.Errorf("%s: %s has no rule", filename, name)
+		}
+	}
+}
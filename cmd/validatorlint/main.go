@@ -0,0 +1,67 @@
+// Command validatorlint reports invalid validation tags in the struct types of Go packages.
+//
+// It parses every tag with the same parser as the validator, and reports the following problems.
+//
+//   - unknown tag names. e.g. `valid:"requried"`
+//   - tags that cannot be applied to the field kind. e.g. `valid:"min(1)"` on a bool
+//   - invalid parameters. e.g. `valid:"max(abc)"`, `valid:"creditcard(visa|foo)"`
+//   - the same problems of the mod tags. e.g. `mod:"trimm"`, `mod:"lower"` on an int
+//   - tagged fields whose types cannot be resolved, because their kinds are not checked
+//
+// Usage:
+//
+//	validatorlint [-tag valid] [-funcs name1,name2] [-modtag mod] [-modfuncs name1,name2] [packages]
+//
+// The packages are the same as the go command, and they are type-checked with their dependencies.
+// The default is `./...`. The custom tag names registered by validator.WithFunc are given by -funcs,
+// and the custom modifying function names registered by validator.WithModFunc are given by -modfuncs.
+// If -modtag is empty, the mod tags are not checked.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	var (
+		tagKey = flag.String("tag", "valid", "key in the struct field's tag")
+		funcs  = flag.String("funcs", "", "comma-separated list of custom tag names")
+
+		modTagKey = flag.String("modtag", "mod", "key in the struct field's tag for modifying")
+		modFuncs  = flag.String("modfuncs", "", "comma-separated list of custom modifying function names")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: validatorlint [flags] [packages]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	var customNames, customModNames []string
+	if *funcs != "" {
+		customNames = strings.Split(*funcs, ",")
+	}
+	if *modFuncs != "" {
+		customModNames = strings.Split(*modFuncs, ",")
+	}
+
+	l := newLinter(*tagKey, *modTagKey, customNames, customModNames)
+	diags, err := l.lintPatterns(patterns)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "validatorlint: %v\n", err)
+		os.Exit(2)
+	}
+	for _, d := range diags {
+		fmt.Println(d)
+	}
+	if len(diags) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+
+	"github.com/utahta/go-validator"
+)
+
+type (
+	// kind represents a set of field kinds.
+	kind uint
+
+	// rule represents the kinds and the parameters that a built-in tag accepts.
+	rule struct {
+		kinds       kind
+		checkParams func(params []string, k kind) error
+	}
+)
+
+const (
+	unknownKind kind = 0
+	boolKind    kind = 1 << iota
+	stringKind
+	intKind
+	uintKind
+	floatKind
+	complexKind
+	sliceKind
+	arrayKind
+	mapKind
+	structKind
+	otherKind
+
+	anyKinds    = ^kind(0)
+	numberKinds = intKind | uintKind | floatKind
+	textKinds   = stringKind | numberKinds
+	lengthKinds = stringKind | sliceKind | arrayKind | mapKind | numberKinds
+)
+
+var rules = map[string]rule{
+	"required": {anyKinds, noParams},
+	"req":      {anyKinds, noParams},
+	"empty":    {anyKinds, noParams},
+
+	"len":        {lengthKinds, numberParams(1, 2)},
+	"length":     {lengthKinds, numberParams(1, 2)},
+	"range":      {lengthKinds, numberParams(1, 2)},
+	"strlen":     {lengthKinds, numberParams(1, 2)},
+	"strlength":  {lengthKinds, numberParams(1, 2)},
+	"runelen":    {lengthKinds, numberParams(1, 2)},
+	"runelength": {lengthKinds, numberParams(1, 2)},
+	"eq":         {lengthKinds, numberParams(1, 1)},
+	"min":        {lengthKinds, numberParams(1, 1)},
+	"max":        {lengthKinds, numberParams(1, 1)},
+	"strmin":     {lengthKinds, numberParams(1, 1)},
+	"strmax":     {lengthKinds, numberParams(1, 1)},
+
+	"or": {anyKinds, anyParams},
+
+	"ip":   {textKinds, noParams},
+	"ipv4": {textKinds, noParams},
+	"ipv6": {textKinds, noParams},
+	"mac":  {textKinds, noParams},
+	"cidr": {textKinds, noParams},
+	"url":  {textKinds, anyParams},
+	"uri":  {textKinds, noParams},
+
+	"creditcard": {textKinds, anyParams}, // the card brands are checked by the validator in parsing.
+	"country":    {textKinds, enumParams("alpha2", "alpha3", "numeric")},
+	"language":   {textKinds, enumParams("alpha2", "alpha3")},
+	"jp_phone":   {textKinds, enumParams("landline", "mobile", "ip", "tollfree")},
+
+	"katakana":           {textKinds, enumParams("space")},
+	"hiragana":           {textKinds, enumParams("space")},
+	"fullwidth_katakana": {textKinds, enumParams("space")},
+	"halfwidth_katakana": {textKinds, enumParams("space")},
+}
+
+// namedTypes represents the types that the tags accept in addition to the kinds of their rules.
+var namedTypes = map[string][]string{
+	"ip":   {"net.IP"},
+	"ipv4": {"net.IP"},
+	"ipv6": {"net.IP"},
+	"mac":  {"net.HardwareAddr"},
+	"cidr": {"net.IPNet"},
+	"url":  {"net/url.URL"},
+}
+
+// modRules represents the kinds and the parameters that the built-in modifying functions accept.
+var modRules = map[string]rule{
+	"trim":  {stringKind, anyParams},
+	"ltrim": {stringKind, anyParams},
+	"rtrim": {stringKind, anyParams},
+	"lower": {stringKind, noParams},
+	"upper": {stringKind, noParams},
+	"kana":  {stringKind, noParams},
+	"nfc":   {stringKind, noParams},
+}
+
+func init() {
+	// the other built-in tags validate the string representation of the field without parameters.
+	for _, name := range validator.TagNames() {
+		if _, ok := rules[name]; !ok {
+			rules[name] = rule{textKinds, noParams}
+		}
+	}
+}
+
+func noParams(params []string, _ kind) error {
+	if len(params) > 0 {
+		return fmt.Errorf("no params required")
+	}
+	return nil
+}
+
+func anyParams([]string, kind) error {
+	return nil
+}
+
+// numberParams returns a function that checks the number of the params and parses them as the number of the kind.
+func numberParams(min, max int) func([]string, kind) error {
+	return func(params []string, k kind) error {
+		if len(params) < min || max < len(params) {
+			if min == max {
+				return fmt.Errorf("%d params required, but got %d", min, len(params))
+			}
+			return fmt.Errorf("%d to %d params required, but got %d", min, max, len(params))
+		}
+
+		for _, param := range params {
+			var err error
+			switch k {
+			case uintKind:
+				_, err = strconv.ParseUint(param, 10, 64)
+			case floatKind:
+				_, err = strconv.ParseFloat(param, 64)
+			default:
+				_, err = strconv.ParseInt(param, 10, 64)
+			}
+			if err != nil {
+				return fmt.Errorf("invalid param %s", param)
+			}
+		}
+		return nil
+	}
+}
+
+// enumParams returns a function that checks the params are one of the values.
+func enumParams(values ...string) func([]string, kind) error {
+	return func(params []string, _ kind) error {
+	loop:
+		for _, param := range params {
+			for _, v := range values {
+				if param == v {
+					continue loop
+				}
+			}
+			return fmt.Errorf("unknown param %s", param)
+		}
+		return nil
+	}
+}
+
+// acceptsType returns true if the tag accepts the type as one of its named types. The pointers are dereferenced.
+func acceptsType(name string, typ types.Type) bool {
+	for {
+		p, ok := typ.(*types.Pointer)
+		if !ok {
+			break
+		}
+		typ = p.Elem()
+	}
+	for _, s := range namedTypes[name] {
+		if types.TypeString(typ, nil) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// unresolved returns true if the type or its element types cannot be resolved, e.g. the package is not found.
+func unresolved(typ types.Type) bool {
+	switch t := typ.(type) {
+	case nil:
+		return true
+	case *types.Basic:
+		return t.Kind() == types.Invalid
+	case *types.Pointer:
+		return unresolved(t.Elem())
+	case *types.Slice:
+		return unresolved(t.Elem())
+	case *types.Array:
+		return unresolved(t.Elem())
+	case *types.Map:
+		return unresolved(t.Key()) || unresolved(t.Elem())
+	}
+	return false
+}
+
+// kindOf returns the kind of the type. The pointers are dereferenced.
+func kindOf(typ types.Type) kind {
+	if typ == nil {
+		return unknownKind
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		return kindOf(t.Elem())
+	case *types.Basic:
+		info := t.Info()
+		switch {
+		case info&types.IsBoolean != 0:
+			return boolKind
+		case info&types.IsString != 0:
+			return stringKind
+		case info&types.IsUnsigned != 0:
+			return uintKind
+		case info&types.IsInteger != 0:
+			return intKind
+		case info&types.IsFloat != 0:
+			return floatKind
+		case info&types.IsComplex != 0:
+			return complexKind
+		}
+	case *types.Slice:
+		return sliceKind
+	case *types.Array:
+		return arrayKind
+	case *types.Map:
+		return mapKind
+	case *types.Struct:
+		return structKind
+	case *types.Chan, *types.Signature:
+		return otherKind
+	}
+	return unknownKind
+}
+
+// elemOf returns the element type of the slice, array or map. The pointers are dereferenced.
+func elemOf(typ types.Type) types.Type {
+	if typ == nil {
+		return nil
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		return elemOf(t.Elem())
+	case *types.Slice:
+		return t.Elem()
+	case *types.Array:
+		return t.Elem()
+	case *types.Map:
+		return t.Elem()
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package bad
+
+import "time"
+
+type (
+	Age int
+
+	User struct {
+		Name     string            `valid:"requried"`
+		Active   bool              `valid:"min(1)"`
+		Age      Age               `valid:"max(abc)"`
+		Score    uint              `valid:"min(-1)"`
+		Nick     *string           `valid:"len(1|2|3)"`
+		Card     string            `valid:"creditcard(VISA|foo)"`
+		Country  string            `valid:"country(alpha4)"`
+		ID       string            `valid:"or(alpha|numbr)"`
+		Code     string            `valid:"or(alpha|min(x))"`
+		Tags     []string          `valid:"max(3);email"`
+		Labels   map[string]bool   `valid:"max(2);alpha"`
+		Matrix   [][]int           `valid:"required;required;min(a)"`
+		Created  time.Time         `valid:"email"`
+		Email    string            `valid:"email(x)"`
+		Slug     string            `valid:"slug"`
+		Broken   string            `valid:"req,,"`
+		Ignored  bool              `valid:"-"`
+		Nothing  bool              `valid:""`
+		Meta     map[string]string `valid:"max(1)"`
+		External unknown.Type      `valid:"min(1),alpha"`
+		Addr     []byte            `valid:"ip"`
+		Site     time.Time         `valid:"url"`
+		Title    string            `mod:"trimm"`
+		Count    int               `mod:"lower"`
+		Prefix   string            `mod:"upper(x)"`
+	}
+)
+
+func f() {
+	_ = struct {
+		Inline bool `valid:"alpha"`
+	}{}
+}
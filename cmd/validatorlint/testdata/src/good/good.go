@@ -0,0 +1,22 @@
+package good
+
+import (
+	"net"
+	"net/url"
+)
+
+type User struct {
+	Name   string            `valid:"required,alphanum,max(32)"`
+	Age    int               `valid:"min(-1),max(150)"`
+	Score  float64           `valid:"min(0.5)"`
+	Card   string            `valid:"creditcard(VISA|jcb)"`
+	IP     net.IP            `valid:"ip"`
+	URL    url.URL           `valid:"url(https)"`
+	Tags   []string          `valid:"max(3);or(alpha|numeric)"`
+	Labels map[string]string `valid:"max(2);required"`
+	Kana   string            `valid:"optional,katakana(space)"`
+	Custom string            `valid:"slug(a|b)"`
+	Host   *net.IP           `valid:"ipv4"`
+	MAC    net.HardwareAddr  `valid:"mac"`
+	Title  string            `mod:"trim(-),nfc" valid:"required"`
+}
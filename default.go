@@ -0,0 +1,297 @@
+package validator
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// DefaultValueError represents an error that occurs when the default value cannot be set to the field.
+	DefaultValueError struct {
+		// Field is a field name. e.g. Foo.Bar.Value
+		Field string
+
+		// Value is a value of the default tag.
+		Value string
+
+		// Type is a type of the field.
+		Type reflect.Type
+
+		// Err is an error that occurred while converting the value.
+		Err error
+	}
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Error returns an error message string.
+func (e *DefaultValueError) Error() string {
+	return fmt.Sprintf("%s: cannot set default value '%s' to %s: %v", e.Field, e.Value, e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DefaultValueError) Unwrap() error {
+	return e.Err
+}
+
+// SetDefaults sets the value of the struct field's default tag to each zero-valued field.
+// The argument must be a pointer to a struct so that the fields are settable.
+// e.g. `default:"8080"`, `default:"1m30s"` for time.Duration, `default:"a,b,c"` for slices.
+func (v *Validator) SetDefaults(s interface{}) error {
+	if s == nil {
+		return nil
+	}
+	value := reflect.ValueOf(s)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("pointer to struct required")
+	}
+	c := v.load()
+	w := c.newWalker(context.Background(), value)
+	defer w.release()
+	return c.setDefaultsStruct(w, Field{origin: value, current: value})
+}
+
+func (c *config) setDefaultsStruct(w *walker, field Field) error {
+	val := field.current
+	if val.Kind() == reflect.Interface || val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("struct type required")
+	}
+
+	ref, ok, err := w.enter(Field{name: field.name, origin: field.origin, current: val})
+	if !ok {
+		return err
+	}
+	defer w.leave(ref)
+
+	fieldCaches := c.loadDefaultCaches(val)
+	for i := 0; i < len(fieldCaches); i++ {
+		originField := val.Field(fieldCaches[i].index)
+
+		if fieldCaches[i].hasDefault && originField.CanSet() && isZero(originField) {
+			if err := setDefaultValue(originField, fieldCaches[i].defaultValue); err != nil {
+				f := newFieldWithParent(fieldCaches[i].name, originField, originField, field)
+				return &DefaultValueError{Field: f.Name(), Value: fieldCaches[i].defaultValue, Type: originField.Type(), Err: err}
+			}
+		}
+
+		valueField := c.extractVar(originField)
+		if err := c.setDefaults(w, newFieldWithParent(fieldCaches[i].name, originField, valueField, field)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setDefaults walks into nested structs.
+func (c *config) setDefaults(w *walker, field Field) error {
+	var val = field.current
+	switch val.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		if !hasStruct(val.Type().Elem()) {
+			return nil
+		}
+		ref, ok, err := w.enter(field)
+		if !ok {
+			return err
+		}
+		defer w.leave(ref)
+
+		if err := w.visit(field, val.Len()); err != nil {
+			return err
+		}
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		if !hasStruct(val.Type().Elem()) {
+			break
+		}
+		for _, k := range sortedMapKeys(val) {
+			// map elements are not settable so modify a copy and store it.
+			value := reflect.New(val.Type().Elem()).Elem()
+			value.Set(val.MapIndex(k))
+
+			err := c.setDefaults(w, newFieldWithParent(fmt.Sprintf("[%v]", k), value, c.extractVar(value), field))
+			if err != nil {
+				return err
+			}
+			val.SetMapIndex(k, value)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if !hasStruct(val.Type().Elem()) {
+			break
+		}
+		for i := 0; i < val.Len(); i++ {
+			value := val.Index(i)
+
+			err := c.setDefaults(w, newFieldWithParent(fmt.Sprintf("[%d]", i), value, c.extractVar(value), field))
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Struct:
+		if !val.CanSet() {
+			break
+		}
+		return c.setDefaultsStruct(w, newFieldWithParent("", field.origin, val, field))
+	}
+	return nil
+}
+
+// loadDefaultCaches returns the field caches of the struct value that have the default tag or may contain a struct.
+// Unlike loadFieldCaches, the valid and mod tags are not parsed, so they do not affect setting defaults.
+func (c *config) loadDefaultCaches(val reflect.Value) []fieldCache {
+	valueType := val.Type()
+	fieldCaches, hasCache := c.defaultCache.Load(valueType)
+	if hasCache {
+		return fieldCaches
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		typeField := valueType.Field(i)
+		if typeField.PkgPath != "" { // private field
+			continue
+		}
+		cache := fieldCache{index: i, name: typeField.Name}
+		cache.defaultValue, cache.hasDefault = typeField.Tag.Lookup(c.defaultTagKey)
+		if !cache.hasDefault && !hasStruct(typeField.Type) {
+			continue
+		}
+		fieldCaches = append(fieldCaches, cache)
+	}
+	c.defaultCache.Store(valueType, fieldCaches)
+
+	return fieldCaches
+}
+
+// isZero returns true if the value is the zero value of its type. an empty slice and map are also zero.
+func isZero(v reflect.Value) bool {
+	if v.Kind() == reflect.Array {
+		return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+	}
+	return isEmpty(Field{current: v})
+}
+
+// hasStruct returns true if the type may contain a struct.
+func hasStruct(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct, reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return hasStruct(t.Elem())
+	}
+	return false
+}
+
+// setDefaultValue converts the string to the type of the value and sets it.
+func setDefaultValue(v reflect.Value, s string) error {
+	if v.Kind() != reflect.Ptr && reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		if err := setDefaultValue(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+
+	case reflect.String:
+		v.SetString(s)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+
+	case reflect.Complex64, reflect.Complex128:
+		c, err := strconv.ParseComplex(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetComplex(c)
+
+	case reflect.Slice:
+		var items []string
+		if s != "" {
+			items = strings.Split(s, ",")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setDefaultValue(slice.Index(i), strings.TrimSpace(item)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+
+	case reflect.Array:
+		var items []string
+		if s != "" {
+			items = strings.Split(s, ",")
+		}
+		if len(items) > v.Len() {
+			return fmt.Errorf("too many values for array of length %d", v.Len())
+		}
+		for i, item := range items {
+			if err := setDefaultValue(v.Index(i), strings.TrimSpace(item)); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return fmt.Errorf("unsupported type")
+	}
+	return nil
+}
+
+// SetDefaults sets the value of the struct field's default tag to each zero-valued field using default validator.
+func SetDefaults(s interface{}) error {
+	return DefaultValidator().SetDefaults(s)
+}
@@ -0,0 +1,215 @@
+package validator_test
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestSetDefaults(t *testing.T) {
+	type (
+		TLS struct {
+			Enabled bool   `default:"true"`
+			Cert    string `default:"/etc/cert.pem"`
+		}
+
+		Backend struct {
+			Weight int `default:"1"`
+		}
+
+		Config struct {
+			Host     string        `default:"localhost"`
+			Port     int           `default:"8080"`
+			Mode     fileMode      `default:"0o755"`
+			MaxConns uint16        `default:"0x100"`
+			Ratio    float32       `default:"0.5"`
+			Phase    complex64     `default:"1+2i"`
+			Debug    bool          `default:"true"`
+			Timeout  time.Duration `default:"1m30s"`
+			Started  time.Time     `default:"2019-05-15T00:00:00Z"`
+			IP       net.IP        `default:"127.0.0.1"`
+			Tags     []string      `default:"a, b,c"`
+			Ports    []int         `default:"80,443"`
+			Empty    []string      `default:""`
+			Pair     [2]int        `default:"1,2"`
+			Retries  *int          `default:"3"`
+			Name     string        `default:"default"`
+			Level    int           `default:"5"`
+			TLS      TLS           // nested struct without tag
+			Proxy    *TLS          // nil pointer is left as it is
+			Backends []Backend     // slice of structs
+			Routes   map[string]*Backend
+			NoTag    string
+			private  string `default:"private"`
+		}
+	)
+
+	c := &Config{
+		Name:     "set",
+		Level:    0,
+		Backends: []Backend{{}, {Weight: 10}},
+		Routes:   map[string]*Backend{"/": {}},
+	}
+	if err := validator.SetDefaults(c); err != nil {
+		t.Fatal(err)
+	}
+
+	retries := 3
+	want := &Config{
+		Host:     "localhost",
+		Port:     8080,
+		Mode:     0755,
+		MaxConns: 256,
+		Ratio:    0.5,
+		Phase:    1 + 2i,
+		Debug:    true,
+		Timeout:  90 * time.Second,
+		Started:  time.Date(2019, 5, 15, 0, 0, 0, 0, time.UTC),
+		IP:       net.ParseIP("127.0.0.1"),
+		Tags:     []string{"a", "b", "c"},
+		Ports:    []int{80, 443},
+		Empty:    []string{},
+		Pair:     [2]int{1, 2},
+		Retries:  &retries,
+		Name:     "set",
+		Level:    5,
+		TLS:      TLS{Enabled: true, Cert: "/etc/cert.pem"},
+		Backends: []Backend{{Weight: 1}, {Weight: 10}},
+		Routes:   map[string]*Backend{"/": {Weight: 1}},
+	}
+	if !reflect.DeepEqual(want, c) {
+		t.Errorf("want %+v, but got %+v", want, c)
+	}
+}
+
+type fileMode uint32
+
+func TestSetDefaults_Invalid(t *testing.T) {
+	type (
+		Valid struct {
+			Port int `default:"8080"`
+		}
+
+		InvalidInt struct {
+			Port int `default:"http"`
+		}
+
+		Overflow struct {
+			Port int8 `default:"128"`
+		}
+
+		InvalidDuration struct {
+			Timeout time.Duration `default:"1 minute"`
+		}
+
+		InvalidSlice struct {
+			Ports []int `default:"80,http"`
+		}
+
+		InvalidArray struct {
+			Pair [2]int `default:"1,2,3"`
+		}
+
+		Unsupported struct {
+			Ch chan int `default:"1"`
+		}
+
+		Nested struct {
+			Inner InvalidInt
+		}
+	)
+
+	testcases := []struct {
+		name      string
+		s         interface{}
+		wantError string
+	}{
+		{"not pointer", Valid{}, "pointer to struct required"},
+		{"not struct", new(string), "struct type required"},
+		{"invalid int", &InvalidInt{}, `Port: cannot set default value 'http' to int: strconv.ParseInt: parsing "http": invalid syntax`},
+		{"overflow", &Overflow{}, `Port: cannot set default value '128' to int8: strconv.ParseInt: parsing "128": value out of range`},
+		{"invalid duration", &InvalidDuration{}, `Timeout: cannot set default value '1 minute' to time.Duration: time: unknown unit " minute" in duration "1 minute"`},
+		{"invalid slice", &InvalidSlice{}, `Ports: cannot set default value '80,http' to []int: strconv.ParseInt: parsing "http": invalid syntax`},
+		{"invalid array", &InvalidArray{}, `Pair: cannot set default value '1,2,3' to [2]int: too many values for array of length 2`},
+		{"unsupported", &Unsupported{}, `Ch: cannot set default value '1' to chan int: unsupported type`},
+		{"nested", &Nested{}, `Inner.Port: cannot set default value 'http' to int: strconv.ParseInt: parsing "http": invalid syntax`},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.New().SetDefaults(tc.s)
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+		})
+	}
+
+	t.Run("typed error", func(t *testing.T) {
+		err := validator.SetDefaults(&InvalidInt{})
+
+		var defaultErr *validator.DefaultValueError
+		if !errors.As(err, &defaultErr) {
+			t.Fatalf("want *DefaultValueError, but got %T", err)
+		}
+		if want, got := "Port", defaultErr.Field; want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+		if want, got := "http", defaultErr.Value; want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+		if !errors.Is(err, defaultErr.Err) {
+			t.Error("want unwrapped error")
+		}
+	})
+}
+
+func TestWithDefaultTagKey(t *testing.T) {
+	type Config struct {
+		Port int `env_default:"8080" default:"80"`
+	}
+
+	c := &Config{}
+	if err := validator.New(validator.WithDefaultTagKey("env_default")).SetDefaults(c); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := 8080, c.Port; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestNormalizeAndValidate_Defaults(t *testing.T) {
+	type Config struct {
+		Host string `default:" LOCALHOST " mod:"trim,lower" valid:"hostname"`
+		Port int    `default:"8080" valid:"port"`
+	}
+
+	c := &Config{}
+	if err := validator.NormalizeAndValidate(c); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := (Config{Host: "localhost", Port: 8080}), *c; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestSetDefaults_IgnoreValidTag(t *testing.T) {
+	type Config struct {
+		Port int    `default:"8080" valid:"unknown"`
+		Host string `mod:"unknown"`
+	}
+
+	c := &Config{}
+	if err := validator.SetDefaults(c); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := 8080, c.Port; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
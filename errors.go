@@ -32,7 +32,13 @@ type (
 		suppressErrorFieldValue bool
 	}
 
-	// Errors represents validation errors
+	// Errors represents validation errors.
+	// The errors are in a deterministic order: the struct fields in the declaration order, the tags of a field in the tag order,
+	// the elements of slices and arrays in the index order, and the elements of maps in the sorted order of the keys.
+	// Strings, numbers and bools are sorted in the natural order, and the other keys in a well-defined fallback order.
+	// Pointer keys are sorted by the values that they point to. The order of the channel and unsafe pointer keys,
+	// and of the pointer keys that point to the equal values, is unspecified; their names are the addresses anyway.
+	// The order is the same with the parallel validation and the I/O-bound validating functions.
 	Errors []Error
 )
 
@@ -3,6 +3,9 @@ package validator_test
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/utahta/go-validator"
 )
@@ -91,3 +94,73 @@ func ExampleValidateStruct_or() {
 	// <nil>
 	// ID: 'abc123' does validate as 'or(alpha|numeric)'
 }
+
+// failureCounter is an observer that counts the failures per tag name.
+type failureCounter struct {
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+func (c *failureCounter) OnValidateStart(context.Context, interface{}) {}
+
+func (c *failureCounter) OnValidateEnd(context.Context, interface{}, error, time.Duration) {}
+
+func (c *failureCounter) OnTag(_ context.Context, _ validator.Field, tag validator.Tag, valid bool, err error, _ time.Duration) {
+	if valid && err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures[tag.Name()]++
+}
+
+func ExampleWithObserver() {
+	type user struct {
+		Name  string   `valid:"required,alphanum"`
+		Email string   `valid:"optional,email"`
+		Tags  []string `valid:";alpha"`
+	}
+
+	counter := &failureCounter{failures: map[string]int{}}
+	v := validator.New(validator.WithObserver(counter))
+	_ = v.ValidateStruct(&user{Name: "", Email: "invalid", Tags: []string{"go", "1", "2"}})
+	_ = v.ValidateStruct(&user{Name: "_", Tags: []string{"go"}})
+
+	var names []string
+	for name := range counter.failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("%s: %d\n", name, counter.failures[name])
+	}
+
+	// Output:
+	// alpha: 2
+	// alphanum: 2
+	// email: 1
+	// required: 1
+}
+
+func ExampleValidator_ParseTag() {
+	v := validator.New()
+	levels, err := v.ParseTag("required,max(3);or(alpha|numeric)")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for i, tags := range levels {
+		for _, tag := range tags {
+			fmt.Println(i, tag.Name(), tag.Params())
+		}
+	}
+
+	_, err = v.ParseTag("requried")
+	fmt.Println(err)
+
+	// Output:
+	// 0 required []
+	// 0 max [3]
+	// 1 or [alpha numeric]
+	// parse: tag requried function not found
+}
@@ -7,5 +7,14 @@ type (
 		name      string
 		tagValue  string
 		tagChunk  *tagChunk
+
+		// modChunk is a parsed mod tag. see loadModCaches.
+		modChunk *tagChunk
+
+		// defaultValue is a value of the default tag.
+		defaultValue string
+
+		// hasDefault is a flag that the field has the default tag.
+		hasDefault bool
 	}
 )
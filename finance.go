@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	cardBrand struct {
+		// prefixes represents ranges of the issuer identification number.
+		// e.g. {"51", "55"} -> 51 to 55
+		prefixes [][2]string
+
+		// lengths represents valid lengths of the card number.
+		lengths []int
+	}
+)
+
+var (
+	// cardBrands represents a map of card brands.
+	cardBrands = map[string]cardBrand{
+		"visa": {
+			prefixes: [][2]string{{"4", "4"}},
+			lengths:  []int{13, 16, 19},
+		},
+		"mastercard": {
+			prefixes: [][2]string{{"51", "55"}, {"2221", "2720"}},
+			lengths:  []int{16},
+		},
+		"amex": {
+			prefixes: [][2]string{{"34", "34"}, {"37", "37"}},
+			lengths:  []int{15},
+		},
+		"discover": {
+			prefixes: [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}, {"622126", "622925"}},
+			lengths:  []int{16, 17, 18, 19},
+		},
+		"jcb": {
+			prefixes: [][2]string{{"3528", "3589"}},
+			lengths:  []int{16, 17, 18, 19},
+		},
+		"dinersclub": {
+			prefixes: [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}},
+			lengths:  []int{14, 15, 16, 17, 18, 19},
+		},
+		"unionpay": {
+			prefixes: [][2]string{{"62", "62"}},
+			lengths:  []int{16, 17, 18, 19},
+		},
+	}
+
+	// ibanLengths represents a map of IBAN country code to IBAN length.
+	ibanLengths = map[string]int{
+		"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+		"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+		"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+		"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+		"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+		"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+		"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+		"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+		"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+		"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+		"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+	}
+
+	// currencyCodes represents a set of ISO 4217 alphabetic currency codes.
+	currencyCodes = map[string]struct{}{
+		"AED": {}, "AFN": {}, "ALL": {}, "AMD": {}, "ANG": {}, "AOA": {}, "ARS": {}, "AUD": {},
+		"AWG": {}, "AZN": {}, "BAM": {}, "BBD": {}, "BDT": {}, "BGN": {}, "BHD": {}, "BIF": {},
+		"BMD": {}, "BND": {}, "BOB": {}, "BOV": {}, "BRL": {}, "BSD": {}, "BTN": {}, "BWP": {},
+		"BYN": {}, "BZD": {}, "CAD": {}, "CDF": {}, "CHE": {}, "CHF": {}, "CHW": {}, "CLF": {},
+		"CLP": {}, "CNY": {}, "COP": {}, "COU": {}, "CRC": {}, "CUC": {}, "CUP": {}, "CVE": {},
+		"CZK": {}, "DJF": {}, "DKK": {}, "DOP": {}, "DZD": {}, "EGP": {}, "ERN": {}, "ETB": {},
+		"EUR": {}, "FJD": {}, "FKP": {}, "GBP": {}, "GEL": {}, "GHS": {}, "GIP": {}, "GMD": {},
+		"GNF": {}, "GTQ": {}, "GYD": {}, "HKD": {}, "HNL": {}, "HTG": {}, "HUF": {}, "IDR": {},
+		"ILS": {}, "INR": {}, "IQD": {}, "IRR": {}, "ISK": {}, "JMD": {}, "JOD": {}, "JPY": {},
+		"KES": {}, "KGS": {}, "KHR": {}, "KMF": {}, "KPW": {}, "KRW": {}, "KWD": {}, "KYD": {},
+		"KZT": {}, "LAK": {}, "LBP": {}, "LKR": {}, "LRD": {}, "LSL": {}, "LYD": {}, "MAD": {},
+		"MDL": {}, "MGA": {}, "MKD": {}, "MMK": {}, "MNT": {}, "MOP": {}, "MRU": {}, "MUR": {},
+		"MVR": {}, "MWK": {}, "MXN": {}, "MXV": {}, "MYR": {}, "MZN": {}, "NAD": {}, "NGN": {},
+		"NIO": {}, "NOK": {}, "NPR": {}, "NZD": {}, "OMR": {}, "PAB": {}, "PEN": {}, "PGK": {},
+		"PHP": {}, "PKR": {}, "PLN": {}, "PYG": {}, "QAR": {}, "RON": {}, "RSD": {}, "RUB": {},
+		"RWF": {}, "SAR": {}, "SBD": {}, "SCR": {}, "SDG": {}, "SEK": {}, "SGD": {}, "SHP": {},
+		"SLE": {}, "SLL": {}, "SOS": {}, "SRD": {}, "SSP": {}, "STN": {}, "SVC": {}, "SYP": {},
+		"SZL": {}, "THB": {}, "TJS": {}, "TMT": {}, "TND": {}, "TOP": {}, "TRY": {}, "TTD": {},
+		"TWD": {}, "TZS": {}, "UAH": {}, "UGX": {}, "USD": {}, "USN": {}, "UYI": {}, "UYU": {},
+		"UYW": {}, "UZS": {}, "VED": {}, "VES": {}, "VND": {}, "VUV": {}, "WST": {}, "XAF": {},
+		"XAG": {}, "XAU": {}, "XBA": {}, "XBB": {}, "XBC": {}, "XBD": {}, "XCD": {}, "XCG": {},
+		"XDR": {}, "XOF": {}, "XPD": {}, "XPF": {}, "XPT": {}, "XSU": {}, "XTS": {}, "XUA": {},
+		"XXX": {}, "YER": {}, "ZAR": {}, "ZMW": {}, "ZWG": {}, "ZWL": {},
+	}
+)
+
+// match returns true if the card number belongs to the brand.
+func (b cardBrand) match(number string) bool {
+	var ok bool
+	for _, l := range b.lengths {
+		if len(number) == l {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	for _, p := range b.prefixes {
+		// the low and high of a range have the same number of digits.
+		prefix := number[:len(p[0])]
+		if p[0] <= prefix && prefix <= p[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeCardNumber removes spaces and hyphens from a card number.
+func normalizeCardNumber(s string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(s)
+}
+
+// luhn returns true if the digits pass the Luhn checksum.
+func luhn(digits string) bool {
+	var sum int
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || '9' < c {
+			return false
+		}
+
+		n := int(c - '0')
+		if double {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// ibanChecksum returns true if the IBAN passes the ISO 7064 mod 97-10 checksum.
+func ibanChecksum(iban string) bool {
+	var rem int
+	rearranged := iban[4:] + iban[:4]
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case '0' <= c && c <= '9':
+			rem = (rem*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return rem == 1
+}
+
+// checkCardBrands returns an error if the parameters of creditcard tag have an unknown card brand.
+func checkCardBrands(params []string) error {
+	for _, name := range params {
+		if _, ok := cardBrands[strings.ToLower(name)]; !ok {
+			return fmt.Errorf("unknown card brand %s", name)
+		}
+	}
+	return nil
+}
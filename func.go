@@ -3,9 +3,12 @@ package validator
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -22,12 +25,39 @@ type (
 		// e.g. len(1|2) -> []string{"1", "2"}
 		TagParams []string
 
-		// v is a Validator instance that is validating.
-		v *Validator
+		// c is the configuration of the validator that is validating.
+		c *config
+
+		// w is the state of the validation. it is shared with the tags that validate the value again, e.g. or.
+		w *walker
 	}
 
 	// Adapter is a validating function adapter.
 	Adapter func(Func) Func
+
+	// TagAdapter is a validating function adapter that receives the tag name, that is the key in FuncMap.
+	// Return fn as is not to adapt the function of the tag.
+	TagAdapter func(name string, fn Func) Func
+
+	// URLOption is an option of the URL validating function.
+	URLOption func(c *urlConfig)
+
+	urlConfig struct {
+		// schemes represents a list of allowed schemes. if empty, any scheme is allowed.
+		schemes []string
+
+		// requireHost is a flag that requires the host.
+		requireHost bool
+
+		// forbidUserinfo is a flag that forbids the userinfo. e.g. user:pass@
+		forbidUserinfo bool
+
+		// forbidPrivateHost is a flag that forbids loopback, private and link-local IP hosts.
+		forbidPrivateHost bool
+
+		// maxLength is a maximum length of the URL in bytes. if 0, it is unlimited.
+		maxLength int
+	}
 )
 
 var (
@@ -71,14 +101,43 @@ var (
 		"hiragana":        isHiragana,
 		"fullwidth":       isFullWidth,
 		"halfwidth":       isHalfWidth,
+		"ip":              isIP,
+		"ipv4":            isIPv4,
+		"ipv6":            isIPv6,
+		"cidr":            isCIDR,
+		"mac":             isMAC,
+		"hostname":        isHostname,
+		"fqdn":            isFQDN,
+		"port":            isPort,
+		"iban":            isIBAN,
+		"bic":             isBIC,
+		"currency":        isCurrency,
+		"country":         isCountry,
+		"language":        isLanguage,
+		"bcp47":           isBCP47Tag,
+
+		// character width.
+		"fullwidth_only":     isFullWidthOnly,
+		"halfwidth_only":     isHalfWidthOnly,
+		"contains_fullwidth": containsFullWidth,
+		"contains_halfwidth": containsHalfWidth,
+
+		// Japan specific.
+		"fullwidth_katakana":  isFullWidthKatakana,
+		"halfwidth_katakana":  isHalfWidthKatakana,
+		"jp_zipcode":          isJPZipCode,
+		"jp_phone":            isJPPhoneNumber,
+		"jp_mynumber":         isJPMyNumber,
+		"jp_corporate_number": isJPCorporateNumber,
 
 		// has parameters.
-		"len":    length,
-		"length": length,
-		"eq":     eqLength,
-		"min":    minLength,
-		"max":    maxLength,
-		"or":     or,
+		"len":        length,
+		"length":     length,
+		"eq":         eqLength,
+		"min":        minLength,
+		"max":        maxLength,
+		"or":         or,
+		"creditcard": isCreditCard,
 
 		// DEPRECATED. these are expected to be removed entirely sometime in the future.
 		"range":      length,
@@ -91,16 +150,75 @@ var (
 	}
 
 	defaultAdapters []Adapter
+
+	// paramCheckers represents a map of the built-in validating functions to the functions that check the parameters in parsing.
+	paramCheckers = map[uintptr]func(params []string) error{
+		reflect.ValueOf(isCreditCard).Pointer(): checkCardBrands,
+	}
+
+	urlType          = reflect.TypeOf(url.URL{})
+	ipType           = reflect.TypeOf(net.IP{})
+	ipNetType        = reflect.TypeOf(net.IPNet{})
+	hardwareAddrType = reflect.TypeOf(net.HardwareAddr{})
 )
 
-// apply applies left to right.
-func apply(fn Func, adapters ...Adapter) Func {
+// TagNames returns the sorted names of the built-in validating functions, which are available in the tags by default.
+// It is useful for the tools that check the tags, e.g. cmd/validatorlint, to know the built-in tags.
+func TagNames() []string {
+	names := make([]string, 0, len(defaultFuncMap))
+	for name := range defaultFuncMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// apply applies the adapters to the function of the tag name left to right,
+// that is the first adapter is the outermost and runs first.
+func apply(name string, fn Func, adapters ...TagAdapter) Func {
 	for i := len(adapters) - 1; i >= 0; i-- {
-		fn = adapters[i](fn)
+		fn = adapters[i](name, fn)
 	}
 	return fn
 }
 
+// tagAdapters converts the adapters to the TagAdapters that adapt the functions of all tags.
+func tagAdapters(adapters []Adapter) []TagAdapter {
+	tas := make([]TagAdapter, 0, len(adapters))
+	for _, a := range adapters {
+		a := a
+		tas = append(tas, func(_ string, fn Func) Func {
+			return a(fn)
+		})
+	}
+	return tas
+}
+
+// AdapterForTags returns a TagAdapter that applies the adapters only to the functions of the tag names.
+// The adapters are applied left to right.
+func AdapterForTags(names []string, adapters ...Adapter) TagAdapter {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return AdapterIf(func(name string) bool {
+		_, ok := set[name]
+		return ok
+	}, adapters...)
+}
+
+// AdapterIf returns a TagAdapter that applies the adapters only to the functions of the tags that satisfy the filter.
+// The adapters are applied left to right.
+func AdapterIf(filter func(name string) bool, adapters ...Adapter) TagAdapter {
+	tas := tagAdapters(adapters)
+	return func(name string, fn Func) Func {
+		if !filter(name) {
+			return fn
+		}
+		return apply(name, fn, tas...)
+	}
+}
+
 // isEmpty return true if value is zero and else false.
 func isEmpty(f Field) bool {
 	v := f.current
@@ -168,11 +286,206 @@ func isISBN13(_ context.Context, f Field, _ FuncOption) (bool, error) {
 	return isbn13Regex.MatchString(f.String()), nil
 }
 
-func isURL(_ context.Context, f Field, _ FuncOption) (bool, error) {
-	u, err := url.ParseRequestURI(f.String())
+func isURL(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	if len(opt.TagParams) > 0 {
+		return validateURL(urlString(f), urlConfig{schemes: opt.TagParams, requireHost: true}), nil
+	}
+	u, err := url.ParseRequestURI(urlString(f))
 	return err == nil && len(u.Scheme) > 0, nil
 }
 
+// WithURLSchemes is a URL option that sets allowed schemes.
+// The tag parameters take precedence over this option. e.g. url(http|https)
+func WithURLSchemes(schemes ...string) URLOption {
+	return func(c *urlConfig) {
+		c.schemes = schemes
+	}
+}
+
+// WithURLRequireHost is a URL option that requires the host.
+func WithURLRequireHost() URLOption {
+	return func(c *urlConfig) {
+		c.requireHost = true
+	}
+}
+
+// WithURLForbidUserinfo is a URL option that forbids the userinfo.
+func WithURLForbidUserinfo() URLOption {
+	return func(c *urlConfig) {
+		c.forbidUserinfo = true
+	}
+}
+
+// WithURLForbidPrivateHost is a URL option that forbids localhost and loopback, private, link-local and unspecified IP hosts.
+// The numeric IPv4 hosts that resolvers accept are also checked, e.g. 2130706433, 0x7f.0.0.1 and 127.1,
+// and the numeric hosts that are out of range are forbidden. Note that host names are not resolved.
+func WithURLForbidPrivateHost() URLOption {
+	return func(c *urlConfig) {
+		c.forbidPrivateHost = true
+	}
+}
+
+// WithURLMaxLength is a URL option that sets the maximum length of the URL in bytes.
+func WithURLMaxLength(n int) URLOption {
+	return func(c *urlConfig) {
+		c.maxLength = n
+	}
+}
+
+// NewURLFunc returns a validating function that validates a URL with options.
+// The tag parameters are treated as allowed schemes. e.g. webhook(https)
+func NewURLFunc(opts ...URLOption) Func {
+	var c urlConfig
+	for _, o := range opts {
+		o(&c)
+	}
+
+	return func(_ context.Context, f Field, opt FuncOption) (bool, error) {
+		conf := c
+		if len(opt.TagParams) > 0 {
+			conf.schemes = opt.TagParams
+		}
+		return validateURL(urlString(f), conf), nil
+	}
+}
+
+// urlString returns a string. The field may be a string or a url.URL.
+func urlString(f Field) string {
+	v := f.current
+	if v.IsValid() && v.Type() == urlType {
+		u := v.Interface().(url.URL)
+		return u.String()
+	}
+	return f.String()
+}
+
+func validateURL(s string, c urlConfig) bool {
+	if c.maxLength > 0 && len(s) > c.maxLength {
+		return false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" {
+		return false
+	}
+
+	if len(c.schemes) > 0 {
+		var ok bool
+		for _, scheme := range c.schemes {
+			if strings.EqualFold(u.Scheme, scheme) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	if c.requireHost && u.Hostname() == "" {
+		return false
+	}
+
+	if c.forbidUserinfo && u.User != nil {
+		return false
+	}
+
+	if c.forbidPrivateHost && isPrivateHost(u.Hostname()) {
+		return false
+	}
+	return true
+}
+
+func isPrivateHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		var ok bool
+		if ip, ok = parseIPv4Numeric(host); !ok {
+			return false
+		}
+		if ip == nil {
+			// the numeric host that is out of range is resolved differently by resolvers, so it is treated as private.
+			return true
+		}
+	}
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+// parseIPv4Numeric parses the host by the rules of inet_aton, which many resolvers accept.
+// The host consists of 1 to 4 parts that are decimal, octal with the prefix 0 or hexadecimal with the prefix 0x,
+// and the last part fills the remaining bytes. e.g. 2130706433, 0x7f.0.0.1 and 127.1 are 127.0.0.1
+// It returns false if the host is not numeric, and returns a nil IP if the host is numeric but out of range.
+func parseIPv4Numeric(host string) (net.IP, bool) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return nil, isNumericHost(parts)
+	}
+
+	nums := make([]uint64, len(parts))
+	for i, p := range parts {
+		base, digits := 10, p
+		switch {
+		case strings.HasPrefix(p, "0x"):
+			base, digits = 16, p[2:]
+		case len(p) > 1 && p[0] == '0':
+			base, digits = 8, p[1:]
+		}
+		if digits == "" && base != 16 {
+			return nil, false
+		}
+		if digits == "" {
+			// 0x is 0 in inet_aton.
+			digits = "0"
+		}
+		n, err := strconv.ParseUint(digits, base, 32)
+		if err != nil {
+			return nil, isNumericHost(parts)
+		}
+		nums[i] = n
+	}
+
+	var addr uint64
+	for i, n := range nums[:len(nums)-1] {
+		if n > 0xff {
+			return nil, true
+		}
+		addr |= n << (8 * (3 - uint(i)))
+	}
+	last := nums[len(nums)-1]
+	if last >= 1<<(8*(5-uint(len(nums)))) {
+		return nil, true
+	}
+	addr |= last
+	return net.IPv4(byte(addr>>24), byte(addr>>16), byte(addr>>8), byte(addr)), true
+}
+
+// isNumericHost reports whether all the parts of the host consist of the digits and the hexadecimal prefix and digits.
+func isNumericHost(parts []string) bool {
+	for _, p := range parts {
+		if p == "" {
+			return false
+		}
+		digits := p
+		if strings.HasPrefix(p, "0x") {
+			digits = p[2:]
+		}
+		for _, r := range digits {
+			if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+				return false
+			}
+		}
+		if digits == p && strings.ContainsAny(p, "abcdef") {
+			return false
+		}
+	}
+	return true
+}
+
 func isURI(_ context.Context, f Field, _ FuncOption) (bool, error) {
 	_, err := url.ParseRequestURI(f.String())
 	return err == nil, nil
@@ -270,22 +583,323 @@ func isSemver(_ context.Context, f Field, _ FuncOption) (bool, error) {
 	return semverRegex.MatchString(f.String()), nil
 }
 
-func isKatakana(_ context.Context, f Field, _ FuncOption) (bool, error) {
-	return katakanaRegex.MatchString(f.String()), nil
+func isKatakana(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	s, err := removeSpaces(f.String(), " \u3000", opt)
+	if err != nil {
+		return false, err
+	}
+	return katakanaRegex.MatchString(s), nil
 }
 
-func isHiragana(_ context.Context, f Field, _ FuncOption) (bool, error) {
-	return hiraganaRegex.MatchString(f.String()), nil
+func isHiragana(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	s, err := removeSpaces(f.String(), " \u3000", opt)
+	if err != nil {
+		return false, err
+	}
+	return hiraganaRegex.MatchString(s), nil
 }
 
+func isFullWidthKatakana(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	s, err := removeSpaces(f.String(), "\u3000", opt)
+	if err != nil {
+		return false, err
+	}
+	return fullWidthKatakanaRegex.MatchString(s), nil
+}
+
+func isHalfWidthKatakana(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	s, err := removeSpaces(f.String(), " ", opt)
+	if err != nil {
+		return false, err
+	}
+	return halfWidthKatakanaRegex.MatchString(s), nil
+}
+
+// isFullWidth returns true if the value contains any character that is not half-width.
+// Use fullwidth_only or contains_fullwidth for precise semantics.
 func isFullWidth(_ context.Context, f Field, _ FuncOption) (bool, error) {
 	return fullWidthRegex.MatchString(f.String()), nil
 }
 
+// isHalfWidth returns true if the value contains any half-width character.
+// Use halfwidth_only or contains_halfwidth for precise semantics.
 func isHalfWidth(_ context.Context, f Field, _ FuncOption) (bool, error) {
 	return halfWidthRegex.MatchString(f.String()), nil
 }
 
+func isFullWidthOnly(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return isAllIn(f.String(), fullWidthTable), nil
+}
+
+func isHalfWidthOnly(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return isAllIn(f.String(), halfWidthTable), nil
+}
+
+func containsFullWidth(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return containsIn(f.String(), fullWidthTable), nil
+}
+
+func containsHalfWidth(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return containsIn(f.String(), halfWidthTable), nil
+}
+
+// parseIP returns the IP address and its textual form.
+// The field may be a string or a net.IP.
+func parseIP(f Field) (net.IP, string) {
+	v := f.current
+	switch {
+	case !v.IsValid():
+		break
+
+	case v.Kind() == reflect.String:
+		return net.ParseIP(v.String()), v.String()
+
+	case v.Type() == ipType:
+		ip := net.IP(v.Bytes())
+		if len(ip) != net.IPv4len && len(ip) != net.IPv6len {
+			return nil, ""
+		}
+		return ip, ip.String()
+	}
+	return nil, ""
+}
+
+func isIP(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	ip, _ := parseIP(f)
+	return ip != nil, nil
+}
+
+func isIPv4(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	ip, s := parseIP(f)
+	return ip != nil && ip.To4() != nil && !strings.Contains(s, ":"), nil
+}
+
+func isIPv6(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	ip, s := parseIP(f)
+	return ip != nil && strings.Contains(s, ":"), nil
+}
+
+func isCIDR(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	v := f.current
+	switch {
+	case !v.IsValid():
+		break
+
+	case v.Kind() == reflect.String:
+		_, _, err := net.ParseCIDR(v.String())
+		return err == nil, nil
+
+	case v.Type() == ipNetType:
+		n := v.Interface().(net.IPNet)
+		if len(n.IP) != net.IPv4len && len(n.IP) != net.IPv6len {
+			return false, nil
+		}
+		_, bits := n.Mask.Size()
+		return bits != 0, nil
+	}
+	return false, nil
+}
+
+func isMAC(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	v := f.current
+	switch {
+	case !v.IsValid():
+		break
+
+	case v.Kind() == reflect.String:
+		_, err := net.ParseMAC(v.String())
+		return err == nil, nil
+
+	case v.Type() == hardwareAddrType:
+		switch v.Len() {
+		case 6, 8, 20:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isHostname(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	s := f.String()
+	return len(s) <= 253 && hostnameRegex.MatchString(s), nil
+}
+
+func isFQDN(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	s := strings.TrimSuffix(f.String(), ".")
+	return len(s) <= 253 && fqdnRegex.MatchString(s), nil
+}
+
+func isPort(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	v := f.current
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return 0 < v.Int() && v.Int() <= 65535, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return 0 < v.Uint() && v.Uint() <= 65535, nil
+
+	case reflect.String:
+		if !numberRegex.MatchString(v.String()) {
+			return false, nil
+		}
+		port, err := parseUint64(v.String())
+		return err == nil && 0 < port && port <= 65535, nil
+	}
+	return false, nil
+}
+
+func isCreditCard(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	number := normalizeCardNumber(f.String())
+	if len(number) < 12 || 19 < len(number) || !luhn(number) {
+		return false, nil
+	}
+	if len(opt.TagParams) == 0 {
+		return true, nil
+	}
+
+	// the card brands are checked in parsing the tag.
+	for _, name := range opt.TagParams {
+		if cardBrands[strings.ToLower(name)].match(number) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isIBAN(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	iban := strings.Replace(f.String(), " ", "", -1)
+	if !ibanRegex.MatchString(iban) {
+		return false, nil
+	}
+
+	l, ok := ibanLengths[iban[:2]]
+	return ok && len(iban) == l && ibanChecksum(iban), nil
+}
+
+func isBIC(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return bicRegex.MatchString(f.String()), nil
+}
+
+func isCurrency(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	_, ok := currencyCodes[f.String()]
+	return ok, nil
+}
+
+func isCountry(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	// the integer is the numeric code, so it is checked as numeric by default.
+	var (
+		code     string
+		variants = []string{"alpha2"}
+	)
+	switch f.current.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		code = fmt.Sprintf("%03d", f.current.Interface())
+		variants = []string{"numeric"}
+	default:
+		code = f.String()
+	}
+
+	c, ok := LookupCountry(code)
+	if !ok {
+		return false, nil
+	}
+
+	if len(opt.TagParams) > 0 {
+		variants = opt.TagParams
+	}
+	for _, variant := range variants {
+		switch variant {
+		case "alpha2":
+			ok = code == c.Alpha2
+		case "alpha3":
+			ok = code == c.Alpha3
+		case "numeric":
+			ok = code == c.Numeric
+		default:
+			return false, fmt.Errorf("unknown country code variant %s", variant)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isLanguage(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	code := f.String()
+	l, ok := LookupLanguage(code)
+	if !ok {
+		return false, nil
+	}
+
+	variants := opt.TagParams
+	if len(variants) == 0 {
+		variants = []string{"alpha2"}
+	}
+	for _, variant := range variants {
+		switch variant {
+		case "alpha2":
+			ok = code == l.Alpha2
+		case "alpha3":
+			ok = code == l.Alpha3 || code == l.Alpha3B
+		default:
+			return false, fmt.Errorf("unknown language code variant %s", variant)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func isBCP47Tag(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return isBCP47(f.String()), nil
+}
+
+func isJPZipCode(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	return jpZipCodeRegex.MatchString(f.String()), nil
+}
+
+func isJPPhoneNumber(_ context.Context, f Field, opt FuncOption) (bool, error) {
+	kind, ok := jpPhoneNumberKind(f.String())
+	if !ok {
+		return false, nil
+	}
+
+	kinds := opt.TagParams
+	if len(kinds) == 0 {
+		kinds = jpPhoneNumberKinds
+	}
+	for _, k := range kinds {
+		switch k {
+		case "landline", "mobile", "ip", "tollfree":
+			if kind == k {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("unknown phone number kind %s", k)
+		}
+	}
+	return false, nil
+}
+
+func isJPMyNumber(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	s := f.String()
+	if len(s) != 12 || !isDigits(s) {
+		return false, nil
+	}
+	return jpMyNumberCheckDigit(s[:11]) == s[11], nil
+}
+
+func isJPCorporateNumber(_ context.Context, f Field, _ FuncOption) (bool, error) {
+	s := f.String()
+	if len(s) != 13 || !isDigits(s) {
+		return false, nil
+	}
+	return jpCorporateNumberCheckDigit(s[1:]) == s[0], nil
+}
+
 func minLength(_ context.Context, f Field, opt FuncOption) (bool, error) {
 	var minStr string
 	if len(opt.TagParams) == 1 {
@@ -452,9 +1066,22 @@ func length(ctx context.Context, f Field, opt FuncOption) (bool, error) {
 	return false, fmt.Errorf("invalid params len")
 }
 
-func or(_ context.Context, f Field, opt FuncOption) (bool, error) {
+func or(ctx context.Context, f Field, opt FuncOption) (bool, error) {
+	w := opt.w
+	if w == nil {
+		w = opt.c.newWalker(ctx, f.current)
+		defer w.release()
+	}
+	// the errors of the parameters are discarded, so they are not counted. the plain walker counts nothing.
+	if !w.plain {
+		w.tentative++
+		defer func() { w.tentative-- }()
+	}
+
 	for _, rawTag := range opt.TagParams {
-		err := opt.v.ValidateVar(f.Interface(), rawTag)
+		value := reflect.ValueOf(f.Interface())
+		field := Field{name: f.name, origin: value, current: opt.c.extractVar(value), parent: f.parent}
+		err := opt.c.validateVar(ctx, w, field, rawTag)
 		if err == nil {
 			return true, nil
 		}
@@ -0,0 +1,78 @@
+//go:build ignore
+// +build ignore
+
+// This program generates locale_table.go from the code tables in the data directory.
+// Invoke it as:
+//
+//	go generate
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen_locale.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package validator")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// countries represents a list of ISO 3166-1 countries.")
+	fmt.Fprintln(&buf, "var countries = []Country{")
+	for _, r := range readTSV("data/iso3166-1.tsv", 4) {
+		fmt.Fprintf(&buf, "{Alpha2: %q, Alpha3: %q, Numeric: %q, Name: %q},\n", r[0], r[1], r[2], r[3])
+	}
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// languages represents a list of ISO 639 languages.")
+	fmt.Fprintln(&buf, "var languages = []Language{")
+	for _, r := range readTSV("data/iso639.tsv", 4) {
+		fmt.Fprintf(&buf, "{Alpha2: %q, Alpha3: %q, Alpha3B: %q, Name: %q},\n", r[0], r[1], r[2], r[3])
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("locale_table.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readTSV reads the tab separated values file. Lines starting with '#' are comments.
+func readTSV(name string, columns int) [][]string {
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var records [][]string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		r := strings.Split(line, "\t")
+		if len(r) != columns {
+			log.Fatalf("%s: invalid line %q", name, line)
+		}
+		records = append(records, r)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return records
+}
@@ -0,0 +1,265 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Generated is the interface implemented by the struct types that have the code generated by cmd/validator-gen.
+	Generated interface {
+		// ValidateWith validates the struct using v by the generated fields and tags.
+		ValidateWith(ctx context.Context, v *Validator) error
+	}
+
+	// GeneratedTags represents the tags embedded in the code generated by cmd/validator-gen.
+	// They are parsed once for each Validator.
+	GeneratedTags struct {
+		rawTags []string
+
+		// parsed is the tags parsed by the last used Validator.
+		parsed atomic.Value
+	}
+
+	// GeneratedWalk represents a validation by the code generated by cmd/validator-gen.
+	// It holds the state of the traversal across the fields and the nested structs, e.g. the current path and the limits.
+	GeneratedWalk struct {
+		ctx   context.Context
+		c     *config
+		w     *walker
+		s     interface{}
+		start time.Time
+
+		// refs is a stack of the references of the entered structs.
+		refs []reference
+
+		// errs is the validation errors in order.
+		errs Errors
+	}
+
+	// ParsedTags represents GeneratedTags parsed by a Validator.
+	ParsedTags struct {
+		c       *config
+		rawTags []string
+		chunks  []*tagChunk
+
+		// err is the first error of the tags in order.
+		err error
+	}
+)
+
+// NewGeneratedTags returns GeneratedTags.
+// It is used by the code generated by cmd/validator-gen.
+func NewGeneratedTags(rawTags ...string) *GeneratedTags {
+	return &GeneratedTags{rawTags: rawTags}
+}
+
+// generatedWalkPool is a pool of GeneratedWalk.
+var generatedWalkPool = sync.Pool{
+	New: func() interface{} {
+		return &GeneratedWalk{}
+	},
+}
+
+// load returns the tags parsed by c.
+func (t *GeneratedTags) load(c *config) *ParsedTags {
+	if p, ok := t.parsed.Load().(*ParsedTags); ok && p.c == c {
+		return p
+	}
+
+	p := &ParsedTags{
+		c:       c,
+		rawTags: t.rawTags,
+		chunks:  make([]*tagChunk, len(t.rawTags)),
+	}
+	for i, rawTag := range t.rawTags {
+		if rawTag == "-" {
+			continue
+		}
+		chunk, err := c.parseTag(rawTag)
+		if err != nil {
+			p.err = err
+			break
+		}
+		p.chunks[i] = chunk
+	}
+	t.parsed.Store(p)
+	return p
+}
+
+// RootField returns the field that represents the struct s itself.
+// It is used by the code generated by cmd/validator-gen.
+func RootField(s interface{}) Field {
+	value := reflect.ValueOf(s)
+	return Field{origin: value, current: value}
+}
+
+// BeginGenerated begins the validation of the struct s by the code generated by cmd/validator-gen using v.
+// Call End when the struct is validated.
+func BeginGenerated(ctx context.Context, v *Validator, s interface{}) *GeneratedWalk {
+	c := v.load()
+	g := generatedWalkPool.Get().(*GeneratedWalk)
+	g.ctx, g.c, g.s = ctx, c, s
+	g.start = c.observeStart(ctx, s)
+	g.w = c.newWalker(ctx, reflect.ValueOf(s))
+	return g
+}
+
+// End runs the postponed validations, and returns the result of the validation.
+// err is the error returned by the generated code. The GeneratedWalk must not be used after End.
+func (g *GeneratedWalk) End(err error) error {
+	if err == nil && len(g.errs) > 0 {
+		err = g.errs
+	}
+	ctx, c, s, start := g.ctx, g.c, g.s, g.start
+	err = c.runAsync(ctx, g.w, err)
+	g.w.release()
+	*g = GeneratedWalk{refs: g.refs[:0]}
+	generatedWalkPool.Put(g)
+	return c.observeEnd(ctx, s, start, err)
+}
+
+// Enter enters the struct of the field. It returns false if the struct is already on the current path, that is a cycle,
+// so the struct should be skipped. It returns LimitError if the depth exceeds the max depth. Call Leave if it returns true.
+// It also checks the context as the validation of the struct by reflection does.
+func (g *GeneratedWalk) Enter(field *Field) (bool, error) {
+	f := *field
+	if f.current.Kind() == reflect.Ptr {
+		// the root field has the pointer to the struct.
+		f.current = f.current.Elem()
+	}
+	ref, ok, err := g.w.enter(f)
+	if !ok {
+		return false, err
+	}
+	if err := g.w.tick(g.ctx, f); err != nil {
+		g.w.leave(ref)
+		return false, err
+	}
+	g.refs = append(g.refs, ref)
+	return true, nil
+}
+
+// Leave leaves the struct that is entered last.
+func (g *GeneratedWalk) Leave() {
+	g.w.leave(g.refs[len(g.refs)-1])
+	g.refs = g.refs[:len(g.refs)-1]
+}
+
+// LoadTags returns tags parsed by the validator. They are parsed unless they are already parsed.
+// It returns the first error of the tags in order, so an invalid tag fails the validation as the validation by reflection does.
+func (g *GeneratedWalk) LoadTags(tags *GeneratedTags) (*ParsedTags, error) {
+	p := tags.load(g.c)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p, nil
+}
+
+// ValidateValue validates the value of the basic type, e.g. string and int, by the i-th tag of tags.
+// The value is validated as is, since it has neither elements nor a nested struct.
+// The validation errors are kept in g, and it returns a non-validation error, e.g. LimitError.
+func (g *GeneratedWalk) ValidateValue(parent *Field, name string, value reflect.Value, tags *ParsedTags, i int) error {
+	chunk := tags.chunks[i]
+	field := newFieldWithParent(name, value, value, *parent)
+	if err := g.w.tick(g.ctx, field); err != nil {
+		return err
+	}
+	ft := g.w.traceField(field, chunk)
+	if chunk.IsOptional() && isEmpty(field) {
+		if ft != nil {
+			ft.Skipped = true
+		}
+		return nil
+	}
+	errs, err := g.c.validateTags(g.ctx, g.w, ft, field, chunk, g.errs)
+	if err != nil {
+		return err
+	}
+	g.errs = errs
+	return nil
+}
+
+// ValidateField validates the value of the field by the i-th tag of tags, including its elements and nested structs.
+// The validation errors are kept in g, and it returns a non-validation error, e.g. LimitError.
+func (g *GeneratedWalk) ValidateField(parent *Field, name string, value reflect.Value, tags *ParsedTags, i int) error {
+	current := g.c.extractVar(value)
+	if !g.c.canValidate(tags.rawTags[i], current.Kind()) {
+		return nil
+	}
+
+	if err := g.c.validate(g.ctx, g.w, newFieldWithParent(name, value, current, *parent), tags.chunks[i]); err != nil {
+		es, ok := err.(Errors)
+		if !ok {
+			return err
+		}
+		g.errs = append(g.errs, es...)
+	}
+	return nil
+}
+
+// ValidateFieldTags validates the value of the field by the i-th tag of tags.
+// Unlike ValidateField, it does not validate the nested struct of the field.
+// Instead, it returns the field and true if the nested struct should be validated with the field as the parent.
+func (g *GeneratedWalk) ValidateFieldTags(parent *Field, name string, value reflect.Value, tags *ParsedTags, i int) (Field, bool, error) {
+	current := g.c.extractVar(value)
+	if !g.c.canValidate(tags.rawTags[i], current.Kind()) {
+		return Field{}, false, nil
+	}
+	chunk := tags.chunks[i]
+
+	field := newFieldWithParent(name, value, current, *parent)
+	if err := g.w.tick(g.ctx, field); err != nil {
+		return Field{}, false, err
+	}
+	ft := g.w.traceField(field, chunk)
+	if chunk.IsOptional() && isEmpty(field) {
+		if ft != nil {
+			ft.Skipped = true
+		}
+		return Field{}, false, nil
+	}
+	errs, err := g.c.validateTags(g.ctx, g.w, ft, field, chunk, g.errs)
+	if err != nil {
+		return Field{}, false, err
+	}
+	g.errs = errs
+	return field, current.Kind() == reflect.Struct, nil
+}
+
+// CheckParity validates s by both the generated code and reflection, and returns an error if the results differ,
+// including the order of the errors.
+// It is used by the test generated by cmd/validator-gen.
+func (v *Validator) CheckParity(ctx context.Context, s Generated) error {
+	generated := s.ValidateWith(ctx, v)
+	reflected := v.ValidateStructContext(ctx, s)
+	if generated == nil && reflected == nil {
+		return nil
+	}
+
+	if !reflect.DeepEqual(errorStrings(generated), errorStrings(reflected)) {
+		return fmt.Errorf("parity: generated `%v`, reflected `%v`", generated, reflected)
+	}
+	return nil
+}
+
+// errorStrings returns the error messages in order.
+func errorStrings(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	es, ok := ToErrors(err)
+	if !ok {
+		return []string{err.Error()}
+	}
+	s := make([]string, 0, len(es))
+	for _, e := range es {
+		s = append(s, e.Error())
+	}
+	return s
+}
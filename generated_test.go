@@ -0,0 +1,59 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+type parityUser struct {
+	Name string `valid:"required"`
+}
+
+var parityUserTags = validator.NewGeneratedTags("required")
+
+func (s *parityUser) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	g := validator.BeginGenerated(ctx, v, s)
+	tags, err := g.LoadTags(parityUserTags)
+	if err != nil {
+		return g.End(err)
+	}
+	root := validator.RootField(s)
+	return g.End(g.ValidateValue(&root, "Name", reflect.ValueOf(&s.Name).Elem(), tags, 0))
+}
+
+type brokenUser struct {
+	Name string `valid:"required"`
+}
+
+func (s *brokenUser) ValidateWith(ctx context.Context, v *validator.Validator) error {
+	return nil
+}
+
+func TestCheckParity(t *testing.T) {
+	v := validator.New()
+	for _, s := range []*parityUser{{}, {Name: "gopher"}} {
+		if err := v.CheckParity(context.Background(), s); err != nil {
+			t.Errorf("want err nil, but got %v", err)
+		}
+	}
+
+	const want = "parity: generated `<nil>`, reflected `Name: '' does validate as 'required'`"
+	if err := v.CheckParity(context.Background(), &brokenUser{}); err == nil || err.Error() != want {
+		t.Errorf("want `%v`, but got `%v`", want, err)
+	}
+}
+
+func TestGeneratedWalk_LoadTags(t *testing.T) {
+	tags := validator.NewGeneratedTags("required", "requried", "-")
+	s := &parityUser{}
+
+	g := validator.BeginGenerated(context.Background(), validator.New(), s)
+	_, err := g.LoadTags(tags)
+	err = g.End(err)
+	if want := "parse: tag requried function not found"; err == nil || err.Error() != want {
+		t.Errorf("want `%v`, but got `%v`", want, err)
+	}
+}
@@ -0,0 +1,89 @@
+package validator
+
+import (
+	"strings"
+)
+
+type (
+	jpPhoneNumberPrefix struct {
+		prefix string
+		kind   string
+	}
+)
+
+var (
+	// jpPhoneNumberPrefixes represents a list of phone number prefixes and kinds. longer prefixes come first.
+	// A landline is a 10-digit number that does not start with any of these prefixes.
+	jpPhoneNumberPrefixes = []jpPhoneNumberPrefix{
+		{"0120", "tollfree"},
+		{"0800", "tollfree"},
+		{"0570", "other"},
+		{"0990", "other"},
+		{"020", "other"},
+		{"050", "ip"},
+		{"070", "mobile"},
+		{"080", "mobile"},
+		{"090", "mobile"},
+	}
+
+	jpPhoneNumberKinds = []string{"landline", "mobile", "ip", "tollfree"}
+)
+
+// jpPhoneNumberKind returns the kind of a Japanese domestic phone number.
+// The number may contain hyphens between the area code, the local exchange code and the subscriber number.
+func jpPhoneNumberKind(s string) (string, bool) {
+	if !jpPhoneRegex.MatchString(s) {
+		return "", false
+	}
+	digits := strings.Replace(s, "-", "", -1)
+
+	for _, p := range jpPhoneNumberPrefixes {
+		if !strings.HasPrefix(digits, p.prefix) {
+			continue
+		}
+		switch p.kind {
+		case "mobile", "ip":
+			return p.kind, len(digits) == 11 && (s == digits || jpPhoneMobileRegex.MatchString(s))
+		case "tollfree":
+			if p.prefix == "0800" {
+				return p.kind, len(digits) == 11 && (s == digits || jpPhoneTollFree0800Regex.MatchString(s))
+			}
+			return p.kind, len(digits) == 10 && (s == digits || jpPhoneTollFree0120Regex.MatchString(s))
+		}
+		return p.kind, false
+	}
+	return "landline", len(digits) == 10 && digits[1] != '0' && (s == digits || jpPhoneLandlineRegex.MatchString(s))
+}
+
+// jpMyNumberCheckDigit returns the check digit of the 11-digit base of an individual number (My Number).
+func jpMyNumberCheckDigit(base string) byte {
+	var sum int
+	for n := 1; n <= 11; n++ {
+		p := int(base[len(base)-n] - '0')
+		q := n - 5
+		if n <= 6 {
+			q = n + 1
+		}
+		sum += p * q
+	}
+
+	rem := sum % 11
+	if rem <= 1 {
+		return '0'
+	}
+	return byte(11-rem) + '0'
+}
+
+// jpCorporateNumberCheckDigit returns the check digit of the 12-digit base of a corporate number.
+func jpCorporateNumberCheckDigit(base string) byte {
+	var sum int
+	for n := 1; n <= 12; n++ {
+		p := int(base[len(base)-n] - '0')
+		q := 1
+		if n%2 == 0 {
+			q = 2
+		}
+		sum += p * q
+	}
+	return byte(9-sum%9) + '0'
+}
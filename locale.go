@@ -0,0 +1,244 @@
+package validator
+
+//go:generate go run gen_locale.go
+
+import (
+	"strings"
+)
+
+type (
+	// Country represents an ISO 3166-1 country.
+	Country struct {
+		// Alpha2 is an alpha-2 code. e.g. JP
+		Alpha2 string
+
+		// Alpha3 is an alpha-3 code. e.g. JPN
+		Alpha3 string
+
+		// Numeric is a numeric code. e.g. 392
+		Numeric string
+
+		// Name is an English short name.
+		Name string
+	}
+
+	// Language represents an ISO 639 language.
+	Language struct {
+		// Alpha2 is an ISO 639-1 code. It is empty if the language does not have it. e.g. ja
+		Alpha2 string
+
+		// Alpha3 is an ISO 639-2/T code. e.g. jpn
+		Alpha3 string
+
+		// Alpha3B is an ISO 639-2/B code. It is empty if it is the same as Alpha3. e.g. ger
+		Alpha3B string
+
+		// Name is an English name.
+		Name string
+	}
+)
+
+var (
+	// countryCodes represents a map of alpha-2, alpha-3 and numeric codes to the country.
+	countryCodes = map[string]Country{}
+
+	// languageCodes represents a map of ISO 639-1, ISO 639-2/T and ISO 639-2/B codes to the language.
+	languageCodes = map[string]Language{}
+
+	// bcp47Grandfathered represents a set of grandfathered tags in lower case.
+	bcp47Grandfathered = map[string]struct{}{
+		"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {}, "i-hak": {},
+		"i-klingon": {}, "i-lux": {}, "i-mingo": {}, "i-navajo": {}, "i-pwn": {}, "i-tao": {},
+		"i-tay": {}, "i-tsu": {}, "sgn-be-fr": {}, "sgn-be-nl": {}, "sgn-ch-de": {},
+		"art-lojban": {}, "cel-gaulish": {}, "no-bok": {}, "no-nyn": {}, "zh-guoyu": {},
+		"zh-hakka": {}, "zh-min": {}, "zh-min-nan": {}, "zh-xiang": {},
+	}
+)
+
+func init() {
+	for _, c := range countries {
+		countryCodes[c.Alpha2] = c
+		countryCodes[c.Alpha3] = c
+		countryCodes[c.Numeric] = c
+	}
+
+	for _, l := range languages {
+		if l.Alpha2 != "" {
+			languageCodes[l.Alpha2] = l
+		}
+		languageCodes[l.Alpha3] = l
+		if l.Alpha3B != "" {
+			languageCodes[l.Alpha3B] = l
+		}
+	}
+}
+
+// LookupCountry returns the country by an ISO 3166-1 alpha-2, alpha-3 or numeric code.
+// The alphabetic codes are upper case. e.g. JP, JPN, 392
+func LookupCountry(code string) (Country, bool) {
+	c, ok := countryCodes[code]
+	return c, ok
+}
+
+// LookupLanguage returns the language by an ISO 639-1, ISO 639-2/T or ISO 639-2/B code.
+// The codes are lower case. e.g. ja, jpn
+func LookupLanguage(code string) (Language, bool) {
+	l, ok := languageCodes[code]
+	return l, ok
+}
+
+// isBCP47 returns true if s is a well-formed BCP 47 language tag.
+// The 2-letter language and region subtags must exist in the code tables.
+func isBCP47(s string) bool {
+	lower := strings.ToLower(s)
+	if _, ok := bcp47Grandfathered[lower]; ok {
+		return true
+	}
+
+	subtags := strings.Split(lower, "-")
+	i := 0
+	next := func() (string, bool) {
+		if i < len(subtags) {
+			return subtags[i], true
+		}
+		return "", false
+	}
+
+	// language
+	st, _ := next()
+	if st == "x" {
+		return isBCP47PrivateUse(subtags[1:])
+	}
+	switch {
+	case len(st) == 2 && isLowerAlpha(st):
+		if _, ok := languageCodes[st]; !ok {
+			return false
+		}
+		i++
+	case len(st) == 3 && isLowerAlpha(st), 5 <= len(st) && len(st) <= 8 && isLowerAlpha(st):
+		i++
+	default:
+		return false
+	}
+
+	// extlang
+	if len(st) <= 3 {
+		for n := 0; n < 3; n++ {
+			st, ok := next()
+			if !ok || len(st) != 3 || !isLowerAlpha(st) {
+				break
+			}
+			i++
+		}
+	}
+
+	// script
+	if st, ok := next(); ok && len(st) == 4 && isLowerAlpha(st) {
+		i++
+	}
+
+	// region
+	if st, ok := next(); ok {
+		switch {
+		case len(st) == 2 && isLowerAlpha(st):
+			if _, ok := countryCodes[strings.ToUpper(st)]; !ok {
+				return false
+			}
+			i++
+		case len(st) == 3 && isDigits(st):
+			i++
+		}
+	}
+
+	// variant
+	variants := map[string]struct{}{}
+	for {
+		st, ok := next()
+		if !ok || !isLowerAlphaNum(st) {
+			break
+		}
+		if !(5 <= len(st) && len(st) <= 8) && !(len(st) == 4 && isDigits(st[:1])) {
+			break
+		}
+		if _, ok := variants[st]; ok {
+			return false
+		}
+		variants[st] = struct{}{}
+		i++
+	}
+
+	// extension
+	singletons := map[string]struct{}{}
+	for {
+		st, ok := next()
+		if !ok || len(st) != 1 || st == "x" || !isLowerAlphaNum(st) {
+			break
+		}
+		if _, ok := singletons[st]; ok {
+			return false
+		}
+		singletons[st] = struct{}{}
+		i++
+
+		n := 0
+		for {
+			st, ok := next()
+			if !ok || len(st) < 2 || 8 < len(st) || !isLowerAlphaNum(st) {
+				break
+			}
+			n++
+			i++
+		}
+		if n == 0 {
+			return false
+		}
+	}
+
+	// private use
+	if st, ok := next(); ok {
+		if st != "x" {
+			return false
+		}
+		return isBCP47PrivateUse(subtags[i+1:])
+	}
+	return true
+}
+
+func isBCP47PrivateUse(subtags []string) bool {
+	if len(subtags) == 0 {
+		return false
+	}
+	for _, st := range subtags {
+		if len(st) < 1 || 8 < len(st) || !isLowerAlphaNum(st) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLowerAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || 'z' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isLowerAlphaNum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || 'z' < s[i]) && (s[i] < '0' || '9' < s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,746 @@
+// Code generated by gen_locale.go; DO NOT EDIT.
+
+package validator
+
+// countries represents a list of ISO 3166-1 countries.
+var countries = []Country{
+	{Alpha2: "AD", Alpha3: "AND", Numeric: "020", Name: "Andorra"},
+	{Alpha2: "AE", Alpha3: "ARE", Numeric: "784", Name: "United Arab Emirates"},
+	{Alpha2: "AF", Alpha3: "AFG", Numeric: "004", Name: "Afghanistan"},
+	{Alpha2: "AG", Alpha3: "ATG", Numeric: "028", Name: "Antigua and Barbuda"},
+	{Alpha2: "AI", Alpha3: "AIA", Numeric: "660", Name: "Anguilla"},
+	{Alpha2: "AL", Alpha3: "ALB", Numeric: "008", Name: "Albania"},
+	{Alpha2: "AM", Alpha3: "ARM", Numeric: "051", Name: "Armenia"},
+	{Alpha2: "AO", Alpha3: "AGO", Numeric: "024", Name: "Angola"},
+	{Alpha2: "AQ", Alpha3: "ATA", Numeric: "010", Name: "Antarctica"},
+	{Alpha2: "AR", Alpha3: "ARG", Numeric: "032", Name: "Argentina"},
+	{Alpha2: "AS", Alpha3: "ASM", Numeric: "016", Name: "American Samoa"},
+	{Alpha2: "AT", Alpha3: "AUT", Numeric: "040", Name: "Austria"},
+	{Alpha2: "AU", Alpha3: "AUS", Numeric: "036", Name: "Australia"},
+	{Alpha2: "AW", Alpha3: "ABW", Numeric: "533", Name: "Aruba"},
+	{Alpha2: "AX", Alpha3: "ALA", Numeric: "248", Name: "Åland Islands"},
+	{Alpha2: "AZ", Alpha3: "AZE", Numeric: "031", Name: "Azerbaijan"},
+	{Alpha2: "BA", Alpha3: "BIH", Numeric: "070", Name: "Bosnia and Herzegovina"},
+	{Alpha2: "BB", Alpha3: "BRB", Numeric: "052", Name: "Barbados"},
+	{Alpha2: "BD", Alpha3: "BGD", Numeric: "050", Name: "Bangladesh"},
+	{Alpha2: "BE", Alpha3: "BEL", Numeric: "056", Name: "Belgium"},
+	{Alpha2: "BF", Alpha3: "BFA", Numeric: "854", Name: "Burkina Faso"},
+	{Alpha2: "BG", Alpha3: "BGR", Numeric: "100", Name: "Bulgaria"},
+	{Alpha2: "BH", Alpha3: "BHR", Numeric: "048", Name: "Bahrain"},
+	{Alpha2: "BI", Alpha3: "BDI", Numeric: "108", Name: "Burundi"},
+	{Alpha2: "BJ", Alpha3: "BEN", Numeric: "204", Name: "Benin"},
+	{Alpha2: "BL", Alpha3: "BLM", Numeric: "652", Name: "Saint Barthélemy"},
+	{Alpha2: "BM", Alpha3: "BMU", Numeric: "060", Name: "Bermuda"},
+	{Alpha2: "BN", Alpha3: "BRN", Numeric: "096", Name: "Brunei Darussalam"},
+	{Alpha2: "BO", Alpha3: "BOL", Numeric: "068", Name: "Bolivia, Plurinational State of"},
+	{Alpha2: "BQ", Alpha3: "BES", Numeric: "535", Name: "Bonaire, Sint Eustatius and Saba"},
+	{Alpha2: "BR", Alpha3: "BRA", Numeric: "076", Name: "Brazil"},
+	{Alpha2: "BS", Alpha3: "BHS", Numeric: "044", Name: "Bahamas"},
+	{Alpha2: "BT", Alpha3: "BTN", Numeric: "064", Name: "Bhutan"},
+	{Alpha2: "BV", Alpha3: "BVT", Numeric: "074", Name: "Bouvet Island"},
+	{Alpha2: "BW", Alpha3: "BWA", Numeric: "072", Name: "Botswana"},
+	{Alpha2: "BY", Alpha3: "BLR", Numeric: "112", Name: "Belarus"},
+	{Alpha2: "BZ", Alpha3: "BLZ", Numeric: "084", Name: "Belize"},
+	{Alpha2: "CA", Alpha3: "CAN", Numeric: "124", Name: "Canada"},
+	{Alpha2: "CC", Alpha3: "CCK", Numeric: "166", Name: "Cocos (Keeling) Islands"},
+	{Alpha2: "CD", Alpha3: "COD", Numeric: "180", Name: "Congo, The Democratic Republic of the"},
+	{Alpha2: "CF", Alpha3: "CAF", Numeric: "140", Name: "Central African Republic"},
+	{Alpha2: "CG", Alpha3: "COG", Numeric: "178", Name: "Congo"},
+	{Alpha2: "CH", Alpha3: "CHE", Numeric: "756", Name: "Switzerland"},
+	{Alpha2: "CI", Alpha3: "CIV", Numeric: "384", Name: "Côte d'Ivoire"},
+	{Alpha2: "CK", Alpha3: "COK", Numeric: "184", Name: "Cook Islands"},
+	{Alpha2: "CL", Alpha3: "CHL", Numeric: "152", Name: "Chile"},
+	{Alpha2: "CM", Alpha3: "CMR", Numeric: "120", Name: "Cameroon"},
+	{Alpha2: "CN", Alpha3: "CHN", Numeric: "156", Name: "China"},
+	{Alpha2: "CO", Alpha3: "COL", Numeric: "170", Name: "Colombia"},
+	{Alpha2: "CR", Alpha3: "CRI", Numeric: "188", Name: "Costa Rica"},
+	{Alpha2: "CU", Alpha3: "CUB", Numeric: "192", Name: "Cuba"},
+	{Alpha2: "CV", Alpha3: "CPV", Numeric: "132", Name: "Cabo Verde"},
+	{Alpha2: "CW", Alpha3: "CUW", Numeric: "531", Name: "Curaçao"},
+	{Alpha2: "CX", Alpha3: "CXR", Numeric: "162", Name: "Christmas Island"},
+	{Alpha2: "CY", Alpha3: "CYP", Numeric: "196", Name: "Cyprus"},
+	{Alpha2: "CZ", Alpha3: "CZE", Numeric: "203", Name: "Czechia"},
+	{Alpha2: "DE", Alpha3: "DEU", Numeric: "276", Name: "Germany"},
+	{Alpha2: "DJ", Alpha3: "DJI", Numeric: "262", Name: "Djibouti"},
+	{Alpha2: "DK", Alpha3: "DNK", Numeric: "208", Name: "Denmark"},
+	{Alpha2: "DM", Alpha3: "DMA", Numeric: "212", Name: "Dominica"},
+	{Alpha2: "DO", Alpha3: "DOM", Numeric: "214", Name: "Dominican Republic"},
+	{Alpha2: "DZ", Alpha3: "DZA", Numeric: "012", Name: "Algeria"},
+	{Alpha2: "EC", Alpha3: "ECU", Numeric: "218", Name: "Ecuador"},
+	{Alpha2: "EE", Alpha3: "EST", Numeric: "233", Name: "Estonia"},
+	{Alpha2: "EG", Alpha3: "EGY", Numeric: "818", Name: "Egypt"},
+	{Alpha2: "EH", Alpha3: "ESH", Numeric: "732", Name: "Western Sahara"},
+	{Alpha2: "ER", Alpha3: "ERI", Numeric: "232", Name: "Eritrea"},
+	{Alpha2: "ES", Alpha3: "ESP", Numeric: "724", Name: "Spain"},
+	{Alpha2: "ET", Alpha3: "ETH", Numeric: "231", Name: "Ethiopia"},
+	{Alpha2: "FI", Alpha3: "FIN", Numeric: "246", Name: "Finland"},
+	{Alpha2: "FJ", Alpha3: "FJI", Numeric: "242", Name: "Fiji"},
+	{Alpha2: "FK", Alpha3: "FLK", Numeric: "238", Name: "Falkland Islands (Malvinas)"},
+	{Alpha2: "FM", Alpha3: "FSM", Numeric: "583", Name: "Micronesia, Federated States of"},
+	{Alpha2: "FO", Alpha3: "FRO", Numeric: "234", Name: "Faroe Islands"},
+	{Alpha2: "FR", Alpha3: "FRA", Numeric: "250", Name: "France"},
+	{Alpha2: "GA", Alpha3: "GAB", Numeric: "266", Name: "Gabon"},
+	{Alpha2: "GB", Alpha3: "GBR", Numeric: "826", Name: "United Kingdom"},
+	{Alpha2: "GD", Alpha3: "GRD", Numeric: "308", Name: "Grenada"},
+	{Alpha2: "GE", Alpha3: "GEO", Numeric: "268", Name: "Georgia"},
+	{Alpha2: "GF", Alpha3: "GUF", Numeric: "254", Name: "French Guiana"},
+	{Alpha2: "GG", Alpha3: "GGY", Numeric: "831", Name: "Guernsey"},
+	{Alpha2: "GH", Alpha3: "GHA", Numeric: "288", Name: "Ghana"},
+	{Alpha2: "GI", Alpha3: "GIB", Numeric: "292", Name: "Gibraltar"},
+	{Alpha2: "GL", Alpha3: "GRL", Numeric: "304", Name: "Greenland"},
+	{Alpha2: "GM", Alpha3: "GMB", Numeric: "270", Name: "Gambia"},
+	{Alpha2: "GN", Alpha3: "GIN", Numeric: "324", Name: "Guinea"},
+	{Alpha2: "GP", Alpha3: "GLP", Numeric: "312", Name: "Guadeloupe"},
+	{Alpha2: "GQ", Alpha3: "GNQ", Numeric: "226", Name: "Equatorial Guinea"},
+	{Alpha2: "GR", Alpha3: "GRC", Numeric: "300", Name: "Greece"},
+	{Alpha2: "GS", Alpha3: "SGS", Numeric: "239", Name: "South Georgia and the South Sandwich Islands"},
+	{Alpha2: "GT", Alpha3: "GTM", Numeric: "320", Name: "Guatemala"},
+	{Alpha2: "GU", Alpha3: "GUM", Numeric: "316", Name: "Guam"},
+	{Alpha2: "GW", Alpha3: "GNB", Numeric: "624", Name: "Guinea-Bissau"},
+	{Alpha2: "GY", Alpha3: "GUY", Numeric: "328", Name: "Guyana"},
+	{Alpha2: "HK", Alpha3: "HKG", Numeric: "344", Name: "Hong Kong"},
+	{Alpha2: "HM", Alpha3: "HMD", Numeric: "334", Name: "Heard Island and McDonald Islands"},
+	{Alpha2: "HN", Alpha3: "HND", Numeric: "340", Name: "Honduras"},
+	{Alpha2: "HR", Alpha3: "HRV", Numeric: "191", Name: "Croatia"},
+	{Alpha2: "HT", Alpha3: "HTI", Numeric: "332", Name: "Haiti"},
+	{Alpha2: "HU", Alpha3: "HUN", Numeric: "348", Name: "Hungary"},
+	{Alpha2: "ID", Alpha3: "IDN", Numeric: "360", Name: "Indonesia"},
+	{Alpha2: "IE", Alpha3: "IRL", Numeric: "372", Name: "Ireland"},
+	{Alpha2: "IL", Alpha3: "ISR", Numeric: "376", Name: "Israel"},
+	{Alpha2: "IM", Alpha3: "IMN", Numeric: "833", Name: "Isle of Man"},
+	{Alpha2: "IN", Alpha3: "IND", Numeric: "356", Name: "India"},
+	{Alpha2: "IO", Alpha3: "IOT", Numeric: "086", Name: "British Indian Ocean Territory"},
+	{Alpha2: "IQ", Alpha3: "IRQ", Numeric: "368", Name: "Iraq"},
+	{Alpha2: "IR", Alpha3: "IRN", Numeric: "364", Name: "Iran, Islamic Republic of"},
+	{Alpha2: "IS", Alpha3: "ISL", Numeric: "352", Name: "Iceland"},
+	{Alpha2: "IT", Alpha3: "ITA", Numeric: "380", Name: "Italy"},
+	{Alpha2: "JE", Alpha3: "JEY", Numeric: "832", Name: "Jersey"},
+	{Alpha2: "JM", Alpha3: "JAM", Numeric: "388", Name: "Jamaica"},
+	{Alpha2: "JO", Alpha3: "JOR", Numeric: "400", Name: "Jordan"},
+	{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", Name: "Japan"},
+	{Alpha2: "KE", Alpha3: "KEN", Numeric: "404", Name: "Kenya"},
+	{Alpha2: "KG", Alpha3: "KGZ", Numeric: "417", Name: "Kyrgyzstan"},
+	{Alpha2: "KH", Alpha3: "KHM", Numeric: "116", Name: "Cambodia"},
+	{Alpha2: "KI", Alpha3: "KIR", Numeric: "296", Name: "Kiribati"},
+	{Alpha2: "KM", Alpha3: "COM", Numeric: "174", Name: "Comoros"},
+	{Alpha2: "KN", Alpha3: "KNA", Numeric: "659", Name: "Saint Kitts and Nevis"},
+	{Alpha2: "KP", Alpha3: "PRK", Numeric: "408", Name: "Korea, Democratic People's Republic of"},
+	{Alpha2: "KR", Alpha3: "KOR", Numeric: "410", Name: "Korea, Republic of"},
+	{Alpha2: "KW", Alpha3: "KWT", Numeric: "414", Name: "Kuwait"},
+	{Alpha2: "KY", Alpha3: "CYM", Numeric: "136", Name: "Cayman Islands"},
+	{Alpha2: "KZ", Alpha3: "KAZ", Numeric: "398", Name: "Kazakhstan"},
+	{Alpha2: "LA", Alpha3: "LAO", Numeric: "418", Name: "Lao People's Democratic Republic"},
+	{Alpha2: "LB", Alpha3: "LBN", Numeric: "422", Name: "Lebanon"},
+	{Alpha2: "LC", Alpha3: "LCA", Numeric: "662", Name: "Saint Lucia"},
+	{Alpha2: "LI", Alpha3: "LIE", Numeric: "438", Name: "Liechtenstein"},
+	{Alpha2: "LK", Alpha3: "LKA", Numeric: "144", Name: "Sri Lanka"},
+	{Alpha2: "LR", Alpha3: "LBR", Numeric: "430", Name: "Liberia"},
+	{Alpha2: "LS", Alpha3: "LSO", Numeric: "426", Name: "Lesotho"},
+	{Alpha2: "LT", Alpha3: "LTU", Numeric: "440", Name: "Lithuania"},
+	{Alpha2: "LU", Alpha3: "LUX", Numeric: "442", Name: "Luxembourg"},
+	{Alpha2: "LV", Alpha3: "LVA", Numeric: "428", Name: "Latvia"},
+	{Alpha2: "LY", Alpha3: "LBY", Numeric: "434", Name: "Libya"},
+	{Alpha2: "MA", Alpha3: "MAR", Numeric: "504", Name: "Morocco"},
+	{Alpha2: "MC", Alpha3: "MCO", Numeric: "492", Name: "Monaco"},
+	{Alpha2: "MD", Alpha3: "MDA", Numeric: "498", Name: "Moldova, Republic of"},
+	{Alpha2: "ME", Alpha3: "MNE", Numeric: "499", Name: "Montenegro"},
+	{Alpha2: "MF", Alpha3: "MAF", Numeric: "663", Name: "Saint Martin (French part)"},
+	{Alpha2: "MG", Alpha3: "MDG", Numeric: "450", Name: "Madagascar"},
+	{Alpha2: "MH", Alpha3: "MHL", Numeric: "584", Name: "Marshall Islands"},
+	{Alpha2: "MK", Alpha3: "MKD", Numeric: "807", Name: "North Macedonia"},
+	{Alpha2: "ML", Alpha3: "MLI", Numeric: "466", Name: "Mali"},
+	{Alpha2: "MM", Alpha3: "MMR", Numeric: "104", Name: "Myanmar"},
+	{Alpha2: "MN", Alpha3: "MNG", Numeric: "496", Name: "Mongolia"},
+	{Alpha2: "MO", Alpha3: "MAC", Numeric: "446", Name: "Macao"},
+	{Alpha2: "MP", Alpha3: "MNP", Numeric: "580", Name: "Northern Mariana Islands"},
+	{Alpha2: "MQ", Alpha3: "MTQ", Numeric: "474", Name: "Martinique"},
+	{Alpha2: "MR", Alpha3: "MRT", Numeric: "478", Name: "Mauritania"},
+	{Alpha2: "MS", Alpha3: "MSR", Numeric: "500", Name: "Montserrat"},
+	{Alpha2: "MT", Alpha3: "MLT", Numeric: "470", Name: "Malta"},
+	{Alpha2: "MU", Alpha3: "MUS", Numeric: "480", Name: "Mauritius"},
+	{Alpha2: "MV", Alpha3: "MDV", Numeric: "462", Name: "Maldives"},
+	{Alpha2: "MW", Alpha3: "MWI", Numeric: "454", Name: "Malawi"},
+	{Alpha2: "MX", Alpha3: "MEX", Numeric: "484", Name: "Mexico"},
+	{Alpha2: "MY", Alpha3: "MYS", Numeric: "458", Name: "Malaysia"},
+	{Alpha2: "MZ", Alpha3: "MOZ", Numeric: "508", Name: "Mozambique"},
+	{Alpha2: "NA", Alpha3: "NAM", Numeric: "516", Name: "Namibia"},
+	{Alpha2: "NC", Alpha3: "NCL", Numeric: "540", Name: "New Caledonia"},
+	{Alpha2: "NE", Alpha3: "NER", Numeric: "562", Name: "Niger"},
+	{Alpha2: "NF", Alpha3: "NFK", Numeric: "574", Name: "Norfolk Island"},
+	{Alpha2: "NG", Alpha3: "NGA", Numeric: "566", Name: "Nigeria"},
+	{Alpha2: "NI", Alpha3: "NIC", Numeric: "558", Name: "Nicaragua"},
+	{Alpha2: "NL", Alpha3: "NLD", Numeric: "528", Name: "Netherlands"},
+	{Alpha2: "NO", Alpha3: "NOR", Numeric: "578", Name: "Norway"},
+	{Alpha2: "NP", Alpha3: "NPL", Numeric: "524", Name: "Nepal"},
+	{Alpha2: "NR", Alpha3: "NRU", Numeric: "520", Name: "Nauru"},
+	{Alpha2: "NU", Alpha3: "NIU", Numeric: "570", Name: "Niue"},
+	{Alpha2: "NZ", Alpha3: "NZL", Numeric: "554", Name: "New Zealand"},
+	{Alpha2: "OM", Alpha3: "OMN", Numeric: "512", Name: "Oman"},
+	{Alpha2: "PA", Alpha3: "PAN", Numeric: "591", Name: "Panama"},
+	{Alpha2: "PE", Alpha3: "PER", Numeric: "604", Name: "Peru"},
+	{Alpha2: "PF", Alpha3: "PYF", Numeric: "258", Name: "French Polynesia"},
+	{Alpha2: "PG", Alpha3: "PNG", Numeric: "598", Name: "Papua New Guinea"},
+	{Alpha2: "PH", Alpha3: "PHL", Numeric: "608", Name: "Philippines"},
+	{Alpha2: "PK", Alpha3: "PAK", Numeric: "586", Name: "Pakistan"},
+	{Alpha2: "PL", Alpha3: "POL", Numeric: "616", Name: "Poland"},
+	{Alpha2: "PM", Alpha3: "SPM", Numeric: "666", Name: "Saint Pierre and Miquelon"},
+	{Alpha2: "PN", Alpha3: "PCN", Numeric: "612", Name: "Pitcairn"},
+	{Alpha2: "PR", Alpha3: "PRI", Numeric: "630", Name: "Puerto Rico"},
+	{Alpha2: "PS", Alpha3: "PSE", Numeric: "275", Name: "Palestine, State of"},
+	{Alpha2: "PT", Alpha3: "PRT", Numeric: "620", Name: "Portugal"},
+	{Alpha2: "PW", Alpha3: "PLW", Numeric: "585", Name: "Palau"},
+	{Alpha2: "PY", Alpha3: "PRY", Numeric: "600", Name: "Paraguay"},
+	{Alpha2: "QA", Alpha3: "QAT", Numeric: "634", Name: "Qatar"},
+	{Alpha2: "RE", Alpha3: "REU", Numeric: "638", Name: "Réunion"},
+	{Alpha2: "RO", Alpha3: "ROU", Numeric: "642", Name: "Romania"},
+	{Alpha2: "RS", Alpha3: "SRB", Numeric: "688", Name: "Serbia"},
+	{Alpha2: "RU", Alpha3: "RUS", Numeric: "643", Name: "Russian Federation"},
+	{Alpha2: "RW", Alpha3: "RWA", Numeric: "646", Name: "Rwanda"},
+	{Alpha2: "SA", Alpha3: "SAU", Numeric: "682", Name: "Saudi Arabia"},
+	{Alpha2: "SB", Alpha3: "SLB", Numeric: "090", Name: "Solomon Islands"},
+	{Alpha2: "SC", Alpha3: "SYC", Numeric: "690", Name: "Seychelles"},
+	{Alpha2: "SD", Alpha3: "SDN", Numeric: "729", Name: "Sudan"},
+	{Alpha2: "SE", Alpha3: "SWE", Numeric: "752", Name: "Sweden"},
+	{Alpha2: "SG", Alpha3: "SGP", Numeric: "702", Name: "Singapore"},
+	{Alpha2: "SH", Alpha3: "SHN", Numeric: "654", Name: "Saint Helena, Ascension and Tristan da Cunha"},
+	{Alpha2: "SI", Alpha3: "SVN", Numeric: "705", Name: "Slovenia"},
+	{Alpha2: "SJ", Alpha3: "SJM", Numeric: "744", Name: "Svalbard and Jan Mayen"},
+	{Alpha2: "SK", Alpha3: "SVK", Numeric: "703", Name: "Slovakia"},
+	{Alpha2: "SL", Alpha3: "SLE", Numeric: "694", Name: "Sierra Leone"},
+	{Alpha2: "SM", Alpha3: "SMR", Numeric: "674", Name: "San Marino"},
+	{Alpha2: "SN", Alpha3: "SEN", Numeric: "686", Name: "Senegal"},
+	{Alpha2: "SO", Alpha3: "SOM", Numeric: "706", Name: "Somalia"},
+	{Alpha2: "SR", Alpha3: "SUR", Numeric: "740", Name: "Suriname"},
+	{Alpha2: "SS", Alpha3: "SSD", Numeric: "728", Name: "South Sudan"},
+	{Alpha2: "ST", Alpha3: "STP", Numeric: "678", Name: "Sao Tome and Principe"},
+	{Alpha2: "SV", Alpha3: "SLV", Numeric: "222", Name: "El Salvador"},
+	{Alpha2: "SX", Alpha3: "SXM", Numeric: "534", Name: "Sint Maarten (Dutch part)"},
+	{Alpha2: "SY", Alpha3: "SYR", Numeric: "760", Name: "Syrian Arab Republic"},
+	{Alpha2: "SZ", Alpha3: "SWZ", Numeric: "748", Name: "Eswatini"},
+	{Alpha2: "TC", Alpha3: "TCA", Numeric: "796", Name: "Turks and Caicos Islands"},
+	{Alpha2: "TD", Alpha3: "TCD", Numeric: "148", Name: "Chad"},
+	{Alpha2: "TF", Alpha3: "ATF", Numeric: "260", Name: "French Southern Territories"},
+	{Alpha2: "TG", Alpha3: "TGO", Numeric: "768", Name: "Togo"},
+	{Alpha2: "TH", Alpha3: "THA", Numeric: "764", Name: "Thailand"},
+	{Alpha2: "TJ", Alpha3: "TJK", Numeric: "762", Name: "Tajikistan"},
+	{Alpha2: "TK", Alpha3: "TKL", Numeric: "772", Name: "Tokelau"},
+	{Alpha2: "TL", Alpha3: "TLS", Numeric: "626", Name: "Timor-Leste"},
+	{Alpha2: "TM", Alpha3: "TKM", Numeric: "795", Name: "Turkmenistan"},
+	{Alpha2: "TN", Alpha3: "TUN", Numeric: "788", Name: "Tunisia"},
+	{Alpha2: "TO", Alpha3: "TON", Numeric: "776", Name: "Tonga"},
+	{Alpha2: "TR", Alpha3: "TUR", Numeric: "792", Name: "Türkiye"},
+	{Alpha2: "TT", Alpha3: "TTO", Numeric: "780", Name: "Trinidad and Tobago"},
+	{Alpha2: "TV", Alpha3: "TUV", Numeric: "798", Name: "Tuvalu"},
+	{Alpha2: "TW", Alpha3: "TWN", Numeric: "158", Name: "Taiwan, Province of China"},
+	{Alpha2: "TZ", Alpha3: "TZA", Numeric: "834", Name: "Tanzania, United Republic of"},
+	{Alpha2: "UA", Alpha3: "UKR", Numeric: "804", Name: "Ukraine"},
+	{Alpha2: "UG", Alpha3: "UGA", Numeric: "800", Name: "Uganda"},
+	{Alpha2: "UM", Alpha3: "UMI", Numeric: "581", Name: "United States Minor Outlying Islands"},
+	{Alpha2: "US", Alpha3: "USA", Numeric: "840", Name: "United States"},
+	{Alpha2: "UY", Alpha3: "URY", Numeric: "858", Name: "Uruguay"},
+	{Alpha2: "UZ", Alpha3: "UZB", Numeric: "860", Name: "Uzbekistan"},
+	{Alpha2: "VA", Alpha3: "VAT", Numeric: "336", Name: "Holy See (Vatican City State)"},
+	{Alpha2: "VC", Alpha3: "VCT", Numeric: "670", Name: "Saint Vincent and the Grenadines"},
+	{Alpha2: "VE", Alpha3: "VEN", Numeric: "862", Name: "Venezuela, Bolivarian Republic of"},
+	{Alpha2: "VG", Alpha3: "VGB", Numeric: "092", Name: "Virgin Islands, British"},
+	{Alpha2: "VI", Alpha3: "VIR", Numeric: "850", Name: "Virgin Islands, U.S."},
+	{Alpha2: "VN", Alpha3: "VNM", Numeric: "704", Name: "Viet Nam"},
+	{Alpha2: "VU", Alpha3: "VUT", Numeric: "548", Name: "Vanuatu"},
+	{Alpha2: "WF", Alpha3: "WLF", Numeric: "876", Name: "Wallis and Futuna"},
+	{Alpha2: "WS", Alpha3: "WSM", Numeric: "882", Name: "Samoa"},
+	{Alpha2: "YE", Alpha3: "YEM", Numeric: "887", Name: "Yemen"},
+	{Alpha2: "YT", Alpha3: "MYT", Numeric: "175", Name: "Mayotte"},
+	{Alpha2: "ZA", Alpha3: "ZAF", Numeric: "710", Name: "South Africa"},
+	{Alpha2: "ZM", Alpha3: "ZMB", Numeric: "894", Name: "Zambia"},
+	{Alpha2: "ZW", Alpha3: "ZWE", Numeric: "716", Name: "Zimbabwe"},
+}
+
+// languages represents a list of ISO 639 languages.
+var languages = []Language{
+	{Alpha2: "aa", Alpha3: "aar", Alpha3B: "", Name: "Afar"},
+	{Alpha2: "ab", Alpha3: "abk", Alpha3B: "", Name: "Abkhazian"},
+	{Alpha2: "", Alpha3: "ace", Alpha3B: "", Name: "Achinese"},
+	{Alpha2: "", Alpha3: "ach", Alpha3B: "", Name: "Acoli"},
+	{Alpha2: "", Alpha3: "ada", Alpha3B: "", Name: "Adangme"},
+	{Alpha2: "", Alpha3: "ady", Alpha3B: "", Name: "Adyghe; Adygei"},
+	{Alpha2: "", Alpha3: "afa", Alpha3B: "", Name: "Afro-Asiatic languages"},
+	{Alpha2: "", Alpha3: "afh", Alpha3B: "", Name: "Afrihili"},
+	{Alpha2: "af", Alpha3: "afr", Alpha3B: "", Name: "Afrikaans"},
+	{Alpha2: "", Alpha3: "ain", Alpha3B: "", Name: "Ainu"},
+	{Alpha2: "ak", Alpha3: "aka", Alpha3B: "", Name: "Akan"},
+	{Alpha2: "", Alpha3: "akk", Alpha3B: "", Name: "Akkadian"},
+	{Alpha2: "", Alpha3: "ale", Alpha3B: "", Name: "Aleut"},
+	{Alpha2: "", Alpha3: "alg", Alpha3B: "", Name: "Algonquian languages"},
+	{Alpha2: "", Alpha3: "alt", Alpha3B: "", Name: "Southern Altai"},
+	{Alpha2: "am", Alpha3: "amh", Alpha3B: "", Name: "Amharic"},
+	{Alpha2: "", Alpha3: "ang", Alpha3B: "", Name: "English, Old (ca. 450-1100)"},
+	{Alpha2: "", Alpha3: "anp", Alpha3B: "", Name: "Angika"},
+	{Alpha2: "", Alpha3: "apa", Alpha3B: "", Name: "Apache languages"},
+	{Alpha2: "ar", Alpha3: "ara", Alpha3B: "", Name: "Arabic"},
+	{Alpha2: "", Alpha3: "arc", Alpha3B: "", Name: "Official Aramaic (700-300 BCE); Imperial Aramaic (700-300 BCE)"},
+	{Alpha2: "an", Alpha3: "arg", Alpha3B: "", Name: "Aragonese"},
+	{Alpha2: "", Alpha3: "arn", Alpha3B: "", Name: "Mapudungun; Mapuche"},
+	{Alpha2: "", Alpha3: "arp", Alpha3B: "", Name: "Arapaho"},
+	{Alpha2: "", Alpha3: "art", Alpha3B: "", Name: "Artificial languages"},
+	{Alpha2: "", Alpha3: "arw", Alpha3B: "", Name: "Arawak"},
+	{Alpha2: "as", Alpha3: "asm", Alpha3B: "", Name: "Assamese"},
+	{Alpha2: "", Alpha3: "ast", Alpha3B: "", Name: "Asturian; Bable; Leonese; Asturleonese"},
+	{Alpha2: "", Alpha3: "ath", Alpha3B: "", Name: "Athapascan languages"},
+	{Alpha2: "", Alpha3: "aus", Alpha3B: "", Name: "Australian languages"},
+	{Alpha2: "av", Alpha3: "ava", Alpha3B: "", Name: "Avaric"},
+	{Alpha2: "ae", Alpha3: "ave", Alpha3B: "", Name: "Avestan"},
+	{Alpha2: "", Alpha3: "awa", Alpha3B: "", Name: "Awadhi"},
+	{Alpha2: "ay", Alpha3: "aym", Alpha3B: "", Name: "Aymara"},
+	{Alpha2: "az", Alpha3: "aze", Alpha3B: "", Name: "Azerbaijani"},
+	{Alpha2: "", Alpha3: "bad", Alpha3B: "", Name: "Banda languages"},
+	{Alpha2: "", Alpha3: "bai", Alpha3B: "", Name: "Bamileke languages"},
+	{Alpha2: "ba", Alpha3: "bak", Alpha3B: "", Name: "Bashkir"},
+	{Alpha2: "", Alpha3: "bal", Alpha3B: "", Name: "Baluchi"},
+	{Alpha2: "bm", Alpha3: "bam", Alpha3B: "", Name: "Bambara"},
+	{Alpha2: "", Alpha3: "ban", Alpha3B: "", Name: "Balinese"},
+	{Alpha2: "", Alpha3: "bas", Alpha3B: "", Name: "Basa"},
+	{Alpha2: "", Alpha3: "bat", Alpha3B: "", Name: "Baltic languages"},
+	{Alpha2: "", Alpha3: "bej", Alpha3B: "", Name: "Beja; Bedawiyet"},
+	{Alpha2: "be", Alpha3: "bel", Alpha3B: "", Name: "Belarusian"},
+	{Alpha2: "", Alpha3: "bem", Alpha3B: "", Name: "Bemba"},
+	{Alpha2: "bn", Alpha3: "ben", Alpha3B: "", Name: "Bengali"},
+	{Alpha2: "", Alpha3: "ber", Alpha3B: "", Name: "Berber languages"},
+	{Alpha2: "", Alpha3: "bho", Alpha3B: "", Name: "Bhojpuri"},
+	{Alpha2: "bh", Alpha3: "bih", Alpha3B: "", Name: "Bihari languages"},
+	{Alpha2: "", Alpha3: "bik", Alpha3B: "", Name: "Bikol"},
+	{Alpha2: "", Alpha3: "bin", Alpha3B: "", Name: "Bini; Edo"},
+	{Alpha2: "bi", Alpha3: "bis", Alpha3B: "", Name: "Bislama"},
+	{Alpha2: "", Alpha3: "bla", Alpha3B: "", Name: "Siksika"},
+	{Alpha2: "", Alpha3: "bnt", Alpha3B: "", Name: "Bantu (Other)"},
+	{Alpha2: "bo", Alpha3: "bod", Alpha3B: "tib", Name: "Tibetan"},
+	{Alpha2: "bs", Alpha3: "bos", Alpha3B: "", Name: "Bosnian"},
+	{Alpha2: "", Alpha3: "bra", Alpha3B: "", Name: "Braj"},
+	{Alpha2: "br", Alpha3: "bre", Alpha3B: "", Name: "Breton"},
+	{Alpha2: "", Alpha3: "btk", Alpha3B: "", Name: "Batak languages"},
+	{Alpha2: "", Alpha3: "bua", Alpha3B: "", Name: "Buriat"},
+	{Alpha2: "", Alpha3: "bug", Alpha3B: "", Name: "Buginese"},
+	{Alpha2: "bg", Alpha3: "bul", Alpha3B: "", Name: "Bulgarian"},
+	{Alpha2: "", Alpha3: "byn", Alpha3B: "", Name: "Blin; Bilin"},
+	{Alpha2: "", Alpha3: "cad", Alpha3B: "", Name: "Caddo"},
+	{Alpha2: "", Alpha3: "cai", Alpha3B: "", Name: "Central American Indian languages"},
+	{Alpha2: "", Alpha3: "car", Alpha3B: "", Name: "Galibi Carib"},
+	{Alpha2: "ca", Alpha3: "cat", Alpha3B: "", Name: "Catalan; Valencian"},
+	{Alpha2: "", Alpha3: "cau", Alpha3B: "", Name: "Caucasian languages"},
+	{Alpha2: "", Alpha3: "ceb", Alpha3B: "", Name: "Cebuano"},
+	{Alpha2: "", Alpha3: "cel", Alpha3B: "", Name: "Celtic languages"},
+	{Alpha2: "cs", Alpha3: "ces", Alpha3B: "cze", Name: "Czech"},
+	{Alpha2: "ch", Alpha3: "cha", Alpha3B: "", Name: "Chamorro"},
+	{Alpha2: "", Alpha3: "chb", Alpha3B: "", Name: "Chibcha"},
+	{Alpha2: "ce", Alpha3: "che", Alpha3B: "", Name: "Chechen"},
+	{Alpha2: "", Alpha3: "chg", Alpha3B: "", Name: "Chagatai"},
+	{Alpha2: "", Alpha3: "chk", Alpha3B: "", Name: "Chuukese"},
+	{Alpha2: "", Alpha3: "chm", Alpha3B: "", Name: "Mari"},
+	{Alpha2: "", Alpha3: "chn", Alpha3B: "", Name: "Chinook jargon"},
+	{Alpha2: "", Alpha3: "cho", Alpha3B: "", Name: "Choctaw"},
+	{Alpha2: "", Alpha3: "chp", Alpha3B: "", Name: "Chipewyan; Dene Suline"},
+	{Alpha2: "", Alpha3: "chr", Alpha3B: "", Name: "Cherokee"},
+	{Alpha2: "cu", Alpha3: "chu", Alpha3B: "", Name: "Church Slavic; Old Slavonic; Church Slavonic; Old Bulgarian; Old Church Slavonic"},
+	{Alpha2: "cv", Alpha3: "chv", Alpha3B: "", Name: "Chuvash"},
+	{Alpha2: "", Alpha3: "chy", Alpha3B: "", Name: "Cheyenne"},
+	{Alpha2: "", Alpha3: "cmc", Alpha3B: "", Name: "Chamic languages"},
+	{Alpha2: "", Alpha3: "cnr", Alpha3B: "", Name: "Montenegrin"},
+	{Alpha2: "", Alpha3: "cop", Alpha3B: "", Name: "Coptic"},
+	{Alpha2: "kw", Alpha3: "cor", Alpha3B: "", Name: "Cornish"},
+	{Alpha2: "co", Alpha3: "cos", Alpha3B: "", Name: "Corsican"},
+	{Alpha2: "", Alpha3: "cpe", Alpha3B: "", Name: "Creoles and pidgins, English based"},
+	{Alpha2: "", Alpha3: "cpf", Alpha3B: "", Name: "Creoles and pidgins, French-based"},
+	{Alpha2: "", Alpha3: "cpp", Alpha3B: "", Name: "Creoles and pidgins, Portuguese-based"},
+	{Alpha2: "cr", Alpha3: "cre", Alpha3B: "", Name: "Cree"},
+	{Alpha2: "", Alpha3: "crh", Alpha3B: "", Name: "Crimean Tatar; Crimean Turkish"},
+	{Alpha2: "", Alpha3: "crp", Alpha3B: "", Name: "Creoles and pidgins"},
+	{Alpha2: "", Alpha3: "csb", Alpha3B: "", Name: "Kashubian"},
+	{Alpha2: "", Alpha3: "cus", Alpha3B: "", Name: "Cushitic languages"},
+	{Alpha2: "cy", Alpha3: "cym", Alpha3B: "wel", Name: "Welsh"},
+	{Alpha2: "", Alpha3: "dak", Alpha3B: "", Name: "Dakota"},
+	{Alpha2: "da", Alpha3: "dan", Alpha3B: "", Name: "Danish"},
+	{Alpha2: "", Alpha3: "dar", Alpha3B: "", Name: "Dargwa"},
+	{Alpha2: "", Alpha3: "day", Alpha3B: "", Name: "Land Dayak languages"},
+	{Alpha2: "", Alpha3: "del", Alpha3B: "", Name: "Delaware"},
+	{Alpha2: "", Alpha3: "den", Alpha3B: "", Name: "Slave (Athapascan)"},
+	{Alpha2: "de", Alpha3: "deu", Alpha3B: "ger", Name: "German"},
+	{Alpha2: "", Alpha3: "dgr", Alpha3B: "", Name: "Dogrib"},
+	{Alpha2: "", Alpha3: "din", Alpha3B: "", Name: "Dinka"},
+	{Alpha2: "dv", Alpha3: "div", Alpha3B: "", Name: "Divehi; Dhivehi; Maldivian"},
+	{Alpha2: "", Alpha3: "doi", Alpha3B: "", Name: "Dogri"},
+	{Alpha2: "", Alpha3: "dra", Alpha3B: "", Name: "Dravidian languages"},
+	{Alpha2: "", Alpha3: "dsb", Alpha3B: "", Name: "Lower Sorbian"},
+	{Alpha2: "", Alpha3: "dua", Alpha3B: "", Name: "Duala"},
+	{Alpha2: "", Alpha3: "dum", Alpha3B: "", Name: "Dutch, Middle (ca. 1050-1350)"},
+	{Alpha2: "", Alpha3: "dyu", Alpha3B: "", Name: "Dyula"},
+	{Alpha2: "dz", Alpha3: "dzo", Alpha3B: "", Name: "Dzongkha"},
+	{Alpha2: "", Alpha3: "efi", Alpha3B: "", Name: "Efik"},
+	{Alpha2: "", Alpha3: "egy", Alpha3B: "", Name: "Egyptian (Ancient)"},
+	{Alpha2: "", Alpha3: "eka", Alpha3B: "", Name: "Ekajuk"},
+	{Alpha2: "el", Alpha3: "ell", Alpha3B: "gre", Name: "Greek, Modern (1453-)"},
+	{Alpha2: "", Alpha3: "elx", Alpha3B: "", Name: "Elamite"},
+	{Alpha2: "en", Alpha3: "eng", Alpha3B: "", Name: "English"},
+	{Alpha2: "", Alpha3: "enm", Alpha3B: "", Name: "English, Middle (1100-1500)"},
+	{Alpha2: "eo", Alpha3: "epo", Alpha3B: "", Name: "Esperanto"},
+	{Alpha2: "et", Alpha3: "est", Alpha3B: "", Name: "Estonian"},
+	{Alpha2: "eu", Alpha3: "eus", Alpha3B: "baq", Name: "Basque"},
+	{Alpha2: "ee", Alpha3: "ewe", Alpha3B: "", Name: "Ewe"},
+	{Alpha2: "", Alpha3: "ewo", Alpha3B: "", Name: "Ewondo"},
+	{Alpha2: "", Alpha3: "fan", Alpha3B: "", Name: "Fang"},
+	{Alpha2: "fo", Alpha3: "fao", Alpha3B: "", Name: "Faroese"},
+	{Alpha2: "fa", Alpha3: "fas", Alpha3B: "per", Name: "Persian"},
+	{Alpha2: "", Alpha3: "fat", Alpha3B: "", Name: "Fanti"},
+	{Alpha2: "fj", Alpha3: "fij", Alpha3B: "", Name: "Fijian"},
+	{Alpha2: "", Alpha3: "fil", Alpha3B: "", Name: "Filipino; Pilipino"},
+	{Alpha2: "fi", Alpha3: "fin", Alpha3B: "", Name: "Finnish"},
+	{Alpha2: "", Alpha3: "fiu", Alpha3B: "", Name: "Finno-Ugrian languages"},
+	{Alpha2: "", Alpha3: "fon", Alpha3B: "", Name: "Fon"},
+	{Alpha2: "fr", Alpha3: "fra", Alpha3B: "fre", Name: "French"},
+	{Alpha2: "", Alpha3: "frm", Alpha3B: "", Name: "French, Middle (ca. 1400-1600)"},
+	{Alpha2: "", Alpha3: "fro", Alpha3B: "", Name: "French, Old (842-ca. 1400)"},
+	{Alpha2: "", Alpha3: "frr", Alpha3B: "", Name: "Northern Frisian"},
+	{Alpha2: "", Alpha3: "frs", Alpha3B: "", Name: "Eastern Frisian"},
+	{Alpha2: "fy", Alpha3: "fry", Alpha3B: "", Name: "Western Frisian"},
+	{Alpha2: "ff", Alpha3: "ful", Alpha3B: "", Name: "Fulah"},
+	{Alpha2: "", Alpha3: "fur", Alpha3B: "", Name: "Friulian"},
+	{Alpha2: "", Alpha3: "gaa", Alpha3B: "", Name: "Ga"},
+	{Alpha2: "", Alpha3: "gay", Alpha3B: "", Name: "Gayo"},
+	{Alpha2: "", Alpha3: "gba", Alpha3B: "", Name: "Gbaya"},
+	{Alpha2: "", Alpha3: "gem", Alpha3B: "", Name: "Germanic languages"},
+	{Alpha2: "", Alpha3: "gez", Alpha3B: "", Name: "Geez"},
+	{Alpha2: "", Alpha3: "gil", Alpha3B: "", Name: "Gilbertese"},
+	{Alpha2: "gd", Alpha3: "gla", Alpha3B: "", Name: "Gaelic; Scottish Gaelic"},
+	{Alpha2: "ga", Alpha3: "gle", Alpha3B: "", Name: "Irish"},
+	{Alpha2: "gl", Alpha3: "glg", Alpha3B: "", Name: "Galician"},
+	{Alpha2: "gv", Alpha3: "glv", Alpha3B: "", Name: "Manx"},
+	{Alpha2: "", Alpha3: "gmh", Alpha3B: "", Name: "German, Middle High (ca. 1050-1500)"},
+	{Alpha2: "", Alpha3: "goh", Alpha3B: "", Name: "German, Old High (ca. 750-1050)"},
+	{Alpha2: "", Alpha3: "gon", Alpha3B: "", Name: "Gondi"},
+	{Alpha2: "", Alpha3: "gor", Alpha3B: "", Name: "Gorontalo"},
+	{Alpha2: "", Alpha3: "got", Alpha3B: "", Name: "Gothic"},
+	{Alpha2: "", Alpha3: "grb", Alpha3B: "", Name: "Grebo"},
+	{Alpha2: "", Alpha3: "grc", Alpha3B: "", Name: "Greek, Ancient (to 1453)"},
+	{Alpha2: "gn", Alpha3: "grn", Alpha3B: "", Name: "Guarani"},
+	{Alpha2: "", Alpha3: "gsw", Alpha3B: "", Name: "Swiss German; Alemannic; Alsatian"},
+	{Alpha2: "gu", Alpha3: "guj", Alpha3B: "", Name: "Gujarati"},
+	{Alpha2: "", Alpha3: "gwi", Alpha3B: "", Name: "Gwich'in"},
+	{Alpha2: "", Alpha3: "hai", Alpha3B: "", Name: "Haida"},
+	{Alpha2: "ht", Alpha3: "hat", Alpha3B: "", Name: "Haitian; Haitian Creole"},
+	{Alpha2: "ha", Alpha3: "hau", Alpha3B: "", Name: "Hausa"},
+	{Alpha2: "", Alpha3: "haw", Alpha3B: "", Name: "Hawaiian"},
+	{Alpha2: "he", Alpha3: "heb", Alpha3B: "", Name: "Hebrew"},
+	{Alpha2: "hz", Alpha3: "her", Alpha3B: "", Name: "Herero"},
+	{Alpha2: "", Alpha3: "hil", Alpha3B: "", Name: "Hiligaynon"},
+	{Alpha2: "", Alpha3: "him", Alpha3B: "", Name: "Himachali languages; Western Pahari languages"},
+	{Alpha2: "hi", Alpha3: "hin", Alpha3B: "", Name: "Hindi"},
+	{Alpha2: "", Alpha3: "hit", Alpha3B: "", Name: "Hittite"},
+	{Alpha2: "", Alpha3: "hmn", Alpha3B: "", Name: "Hmong; Mong"},
+	{Alpha2: "ho", Alpha3: "hmo", Alpha3B: "", Name: "Hiri Motu"},
+	{Alpha2: "hr", Alpha3: "hrv", Alpha3B: "", Name: "Croatian"},
+	{Alpha2: "", Alpha3: "hsb", Alpha3B: "", Name: "Upper Sorbian"},
+	{Alpha2: "hu", Alpha3: "hun", Alpha3B: "", Name: "Hungarian"},
+	{Alpha2: "", Alpha3: "hup", Alpha3B: "", Name: "Hupa"},
+	{Alpha2: "hy", Alpha3: "hye", Alpha3B: "arm", Name: "Armenian"},
+	{Alpha2: "", Alpha3: "iba", Alpha3B: "", Name: "Iban"},
+	{Alpha2: "ig", Alpha3: "ibo", Alpha3B: "", Name: "Igbo"},
+	{Alpha2: "io", Alpha3: "ido", Alpha3B: "", Name: "Ido"},
+	{Alpha2: "ii", Alpha3: "iii", Alpha3B: "", Name: "Sichuan Yi; Nuosu"},
+	{Alpha2: "", Alpha3: "ijo", Alpha3B: "", Name: "Ijo languages"},
+	{Alpha2: "iu", Alpha3: "iku", Alpha3B: "", Name: "Inuktitut"},
+	{Alpha2: "ie", Alpha3: "ile", Alpha3B: "", Name: "Interlingue; Occidental"},
+	{Alpha2: "", Alpha3: "ilo", Alpha3B: "", Name: "Iloko"},
+	{Alpha2: "ia", Alpha3: "ina", Alpha3B: "", Name: "Interlingua (International Auxiliary Language Association)"},
+	{Alpha2: "", Alpha3: "inc", Alpha3B: "", Name: "Indic languages"},
+	{Alpha2: "id", Alpha3: "ind", Alpha3B: "", Name: "Indonesian"},
+	{Alpha2: "", Alpha3: "ine", Alpha3B: "", Name: "Indo-European languages"},
+	{Alpha2: "", Alpha3: "inh", Alpha3B: "", Name: "Ingush"},
+	{Alpha2: "ik", Alpha3: "ipk", Alpha3B: "", Name: "Inupiaq"},
+	{Alpha2: "", Alpha3: "ira", Alpha3B: "", Name: "Iranian languages"},
+	{Alpha2: "", Alpha3: "iro", Alpha3B: "", Name: "Iroquoian languages"},
+	{Alpha2: "is", Alpha3: "isl", Alpha3B: "ice", Name: "Icelandic"},
+	{Alpha2: "it", Alpha3: "ita", Alpha3B: "", Name: "Italian"},
+	{Alpha2: "jv", Alpha3: "jav", Alpha3B: "", Name: "Javanese"},
+	{Alpha2: "", Alpha3: "jbo", Alpha3B: "", Name: "Lojban"},
+	{Alpha2: "ja", Alpha3: "jpn", Alpha3B: "", Name: "Japanese"},
+	{Alpha2: "", Alpha3: "jpr", Alpha3B: "", Name: "Judeo-Persian"},
+	{Alpha2: "", Alpha3: "jrb", Alpha3B: "", Name: "Judeo-Arabic"},
+	{Alpha2: "", Alpha3: "kaa", Alpha3B: "", Name: "Kara-Kalpak"},
+	{Alpha2: "", Alpha3: "kab", Alpha3B: "", Name: "Kabyle"},
+	{Alpha2: "", Alpha3: "kac", Alpha3B: "", Name: "Kachin; Jingpho"},
+	{Alpha2: "kl", Alpha3: "kal", Alpha3B: "", Name: "Kalaallisut; Greenlandic"},
+	{Alpha2: "", Alpha3: "kam", Alpha3B: "", Name: "Kamba"},
+	{Alpha2: "kn", Alpha3: "kan", Alpha3B: "", Name: "Kannada"},
+	{Alpha2: "", Alpha3: "kar", Alpha3B: "", Name: "Karen languages"},
+	{Alpha2: "ks", Alpha3: "kas", Alpha3B: "", Name: "Kashmiri"},
+	{Alpha2: "ka", Alpha3: "kat", Alpha3B: "geo", Name: "Georgian"},
+	{Alpha2: "kr", Alpha3: "kau", Alpha3B: "", Name: "Kanuri"},
+	{Alpha2: "", Alpha3: "kaw", Alpha3B: "", Name: "Kawi"},
+	{Alpha2: "kk", Alpha3: "kaz", Alpha3B: "", Name: "Kazakh"},
+	{Alpha2: "", Alpha3: "kbd", Alpha3B: "", Name: "Kabardian"},
+	{Alpha2: "", Alpha3: "kha", Alpha3B: "", Name: "Khasi"},
+	{Alpha2: "", Alpha3: "khi", Alpha3B: "", Name: "Khoisan languages"},
+	{Alpha2: "km", Alpha3: "khm", Alpha3B: "", Name: "Central Khmer"},
+	{Alpha2: "", Alpha3: "kho", Alpha3B: "", Name: "Khotanese; Sakan"},
+	{Alpha2: "ki", Alpha3: "kik", Alpha3B: "", Name: "Kikuyu; Gikuyu"},
+	{Alpha2: "rw", Alpha3: "kin", Alpha3B: "", Name: "Kinyarwanda"},
+	{Alpha2: "ky", Alpha3: "kir", Alpha3B: "", Name: "Kirghiz; Kyrgyz"},
+	{Alpha2: "", Alpha3: "kmb", Alpha3B: "", Name: "Kimbundu"},
+	{Alpha2: "", Alpha3: "kok", Alpha3B: "", Name: "Konkani"},
+	{Alpha2: "kv", Alpha3: "kom", Alpha3B: "", Name: "Komi"},
+	{Alpha2: "kg", Alpha3: "kon", Alpha3B: "", Name: "Kongo"},
+	{Alpha2: "ko", Alpha3: "kor", Alpha3B: "", Name: "Korean"},
+	{Alpha2: "", Alpha3: "kos", Alpha3B: "", Name: "Kosraean"},
+	{Alpha2: "", Alpha3: "kpe", Alpha3B: "", Name: "Kpelle"},
+	{Alpha2: "", Alpha3: "krc", Alpha3B: "", Name: "Karachay-Balkar"},
+	{Alpha2: "", Alpha3: "krl", Alpha3B: "", Name: "Karelian"},
+	{Alpha2: "", Alpha3: "kro", Alpha3B: "", Name: "Kru languages"},
+	{Alpha2: "", Alpha3: "kru", Alpha3B: "", Name: "Kurukh"},
+	{Alpha2: "kj", Alpha3: "kua", Alpha3B: "", Name: "Kuanyama; Kwanyama"},
+	{Alpha2: "", Alpha3: "kum", Alpha3B: "", Name: "Kumyk"},
+	{Alpha2: "ku", Alpha3: "kur", Alpha3B: "", Name: "Kurdish"},
+	{Alpha2: "", Alpha3: "kut", Alpha3B: "", Name: "Kutenai"},
+	{Alpha2: "", Alpha3: "lad", Alpha3B: "", Name: "Ladino"},
+	{Alpha2: "", Alpha3: "lah", Alpha3B: "", Name: "Lahnda"},
+	{Alpha2: "", Alpha3: "lam", Alpha3B: "", Name: "Lamba"},
+	{Alpha2: "lo", Alpha3: "lao", Alpha3B: "", Name: "Lao"},
+	{Alpha2: "la", Alpha3: "lat", Alpha3B: "", Name: "Latin"},
+	{Alpha2: "lv", Alpha3: "lav", Alpha3B: "", Name: "Latvian"},
+	{Alpha2: "", Alpha3: "lez", Alpha3B: "", Name: "Lezghian"},
+	{Alpha2: "li", Alpha3: "lim", Alpha3B: "", Name: "Limburgan; Limburger; Limburgish"},
+	{Alpha2: "ln", Alpha3: "lin", Alpha3B: "", Name: "Lingala"},
+	{Alpha2: "lt", Alpha3: "lit", Alpha3B: "", Name: "Lithuanian"},
+	{Alpha2: "", Alpha3: "lol", Alpha3B: "", Name: "Mongo"},
+	{Alpha2: "", Alpha3: "loz", Alpha3B: "", Name: "Lozi"},
+	{Alpha2: "lb", Alpha3: "ltz", Alpha3B: "", Name: "Luxembourgish; Letzeburgesch"},
+	{Alpha2: "", Alpha3: "lua", Alpha3B: "", Name: "Luba-Lulua"},
+	{Alpha2: "lu", Alpha3: "lub", Alpha3B: "", Name: "Luba-Katanga"},
+	{Alpha2: "lg", Alpha3: "lug", Alpha3B: "", Name: "Ganda"},
+	{Alpha2: "", Alpha3: "lui", Alpha3B: "", Name: "Luiseno"},
+	{Alpha2: "", Alpha3: "lun", Alpha3B: "", Name: "Lunda"},
+	{Alpha2: "", Alpha3: "luo", Alpha3B: "", Name: "Luo (Kenya and Tanzania)"},
+	{Alpha2: "", Alpha3: "lus", Alpha3B: "", Name: "Lushai"},
+	{Alpha2: "", Alpha3: "mad", Alpha3B: "", Name: "Madurese"},
+	{Alpha2: "", Alpha3: "mag", Alpha3B: "", Name: "Magahi"},
+	{Alpha2: "mh", Alpha3: "mah", Alpha3B: "", Name: "Marshallese"},
+	{Alpha2: "", Alpha3: "mai", Alpha3B: "", Name: "Maithili"},
+	{Alpha2: "", Alpha3: "mak", Alpha3B: "", Name: "Makasar"},
+	{Alpha2: "ml", Alpha3: "mal", Alpha3B: "", Name: "Malayalam"},
+	{Alpha2: "", Alpha3: "man", Alpha3B: "", Name: "Mandingo"},
+	{Alpha2: "", Alpha3: "map", Alpha3B: "", Name: "Austronesian languages"},
+	{Alpha2: "mr", Alpha3: "mar", Alpha3B: "", Name: "Marathi"},
+	{Alpha2: "", Alpha3: "mas", Alpha3B: "", Name: "Masai"},
+	{Alpha2: "", Alpha3: "mdf", Alpha3B: "", Name: "Moksha"},
+	{Alpha2: "", Alpha3: "mdr", Alpha3B: "", Name: "Mandar"},
+	{Alpha2: "", Alpha3: "men", Alpha3B: "", Name: "Mende"},
+	{Alpha2: "", Alpha3: "mga", Alpha3B: "", Name: "Irish, Middle (900-1200)"},
+	{Alpha2: "", Alpha3: "mic", Alpha3B: "", Name: "Mi'kmaq; Micmac"},
+	{Alpha2: "", Alpha3: "min", Alpha3B: "", Name: "Minangkabau"},
+	{Alpha2: "", Alpha3: "mis", Alpha3B: "", Name: "Uncoded languages"},
+	{Alpha2: "mk", Alpha3: "mkd", Alpha3B: "mac", Name: "Macedonian"},
+	{Alpha2: "", Alpha3: "mkh", Alpha3B: "", Name: "Mon-Khmer languages"},
+	{Alpha2: "mg", Alpha3: "mlg", Alpha3B: "", Name: "Malagasy"},
+	{Alpha2: "mt", Alpha3: "mlt", Alpha3B: "", Name: "Maltese"},
+	{Alpha2: "", Alpha3: "mnc", Alpha3B: "", Name: "Manchu"},
+	{Alpha2: "", Alpha3: "mni", Alpha3B: "", Name: "Manipuri"},
+	{Alpha2: "", Alpha3: "mno", Alpha3B: "", Name: "Manobo languages"},
+	{Alpha2: "", Alpha3: "moh", Alpha3B: "", Name: "Mohawk"},
+	{Alpha2: "mn", Alpha3: "mon", Alpha3B: "", Name: "Mongolian"},
+	{Alpha2: "", Alpha3: "mos", Alpha3B: "", Name: "Mossi"},
+	{Alpha2: "mi", Alpha3: "mri", Alpha3B: "mao", Name: "Maori"},
+	{Alpha2: "ms", Alpha3: "msa", Alpha3B: "may", Name: "Malay"},
+	{Alpha2: "", Alpha3: "mul", Alpha3B: "", Name: "Multiple languages"},
+	{Alpha2: "", Alpha3: "mun", Alpha3B: "", Name: "Munda languages"},
+	{Alpha2: "", Alpha3: "mus", Alpha3B: "", Name: "Creek"},
+	{Alpha2: "", Alpha3: "mwl", Alpha3B: "", Name: "Mirandese"},
+	{Alpha2: "", Alpha3: "mwr", Alpha3B: "", Name: "Marwari"},
+	{Alpha2: "my", Alpha3: "mya", Alpha3B: "bur", Name: "Burmese"},
+	{Alpha2: "", Alpha3: "myn", Alpha3B: "", Name: "Mayan languages"},
+	{Alpha2: "", Alpha3: "myv", Alpha3B: "", Name: "Erzya"},
+	{Alpha2: "", Alpha3: "nah", Alpha3B: "", Name: "Nahuatl languages"},
+	{Alpha2: "", Alpha3: "nai", Alpha3B: "", Name: "North American Indian languages"},
+	{Alpha2: "", Alpha3: "nap", Alpha3B: "", Name: "Neapolitan"},
+	{Alpha2: "na", Alpha3: "nau", Alpha3B: "", Name: "Nauru"},
+	{Alpha2: "nv", Alpha3: "nav", Alpha3B: "", Name: "Navajo; Navaho"},
+	{Alpha2: "nr", Alpha3: "nbl", Alpha3B: "", Name: "Ndebele, South; South Ndebele"},
+	{Alpha2: "nd", Alpha3: "nde", Alpha3B: "", Name: "Ndebele, North; North Ndebele"},
+	{Alpha2: "ng", Alpha3: "ndo", Alpha3B: "", Name: "Ndonga"},
+	{Alpha2: "", Alpha3: "nds", Alpha3B: "", Name: "Low German; Low Saxon; German, Low; Saxon, Low"},
+	{Alpha2: "ne", Alpha3: "nep", Alpha3B: "", Name: "Nepali"},
+	{Alpha2: "", Alpha3: "new", Alpha3B: "", Name: "Nepal Bhasa; Newari"},
+	{Alpha2: "", Alpha3: "nia", Alpha3B: "", Name: "Nias"},
+	{Alpha2: "", Alpha3: "nic", Alpha3B: "", Name: "Niger-Kordofanian languages"},
+	{Alpha2: "", Alpha3: "niu", Alpha3B: "", Name: "Niuean"},
+	{Alpha2: "nl", Alpha3: "nld", Alpha3B: "dut", Name: "Dutch; Flemish"},
+	{Alpha2: "nn", Alpha3: "nno", Alpha3B: "", Name: "Norwegian Nynorsk; Nynorsk, Norwegian"},
+	{Alpha2: "nb", Alpha3: "nob", Alpha3B: "", Name: "Bokmål, Norwegian; Norwegian Bokmål"},
+	{Alpha2: "", Alpha3: "nog", Alpha3B: "", Name: "Nogai"},
+	{Alpha2: "", Alpha3: "non", Alpha3B: "", Name: "Norse, Old"},
+	{Alpha2: "no", Alpha3: "nor", Alpha3B: "", Name: "Norwegian"},
+	{Alpha2: "", Alpha3: "nqo", Alpha3B: "", Name: "N'Ko"},
+	{Alpha2: "", Alpha3: "nso", Alpha3B: "", Name: "Pedi; Sepedi; Northern Sotho"},
+	{Alpha2: "", Alpha3: "nub", Alpha3B: "", Name: "Nubian languages"},
+	{Alpha2: "", Alpha3: "nwc", Alpha3B: "", Name: "Classical Newari; Old Newari; Classical Nepal Bhasa"},
+	{Alpha2: "ny", Alpha3: "nya", Alpha3B: "", Name: "Chichewa; Chewa; Nyanja"},
+	{Alpha2: "", Alpha3: "nym", Alpha3B: "", Name: "Nyamwezi"},
+	{Alpha2: "", Alpha3: "nyn", Alpha3B: "", Name: "Nyankole"},
+	{Alpha2: "", Alpha3: "nyo", Alpha3B: "", Name: "Nyoro"},
+	{Alpha2: "", Alpha3: "nzi", Alpha3B: "", Name: "Nzima"},
+	{Alpha2: "oc", Alpha3: "oci", Alpha3B: "", Name: "Occitan (post 1500); Provençal"},
+	{Alpha2: "oj", Alpha3: "oji", Alpha3B: "", Name: "Ojibwa"},
+	{Alpha2: "or", Alpha3: "ori", Alpha3B: "", Name: "Oriya"},
+	{Alpha2: "om", Alpha3: "orm", Alpha3B: "", Name: "Oromo"},
+	{Alpha2: "", Alpha3: "osa", Alpha3B: "", Name: "Osage"},
+	{Alpha2: "os", Alpha3: "oss", Alpha3B: "", Name: "Ossetian; Ossetic"},
+	{Alpha2: "", Alpha3: "ota", Alpha3B: "", Name: "Turkish, Ottoman (1500-1928)"},
+	{Alpha2: "", Alpha3: "oto", Alpha3B: "", Name: "Otomian languages"},
+	{Alpha2: "", Alpha3: "paa", Alpha3B: "", Name: "Papuan languages"},
+	{Alpha2: "", Alpha3: "pag", Alpha3B: "", Name: "Pangasinan"},
+	{Alpha2: "", Alpha3: "pal", Alpha3B: "", Name: "Pahlavi"},
+	{Alpha2: "", Alpha3: "pam", Alpha3B: "", Name: "Pampanga; Kapampangan"},
+	{Alpha2: "pa", Alpha3: "pan", Alpha3B: "", Name: "Panjabi; Punjabi"},
+	{Alpha2: "", Alpha3: "pap", Alpha3B: "", Name: "Papiamento"},
+	{Alpha2: "", Alpha3: "pau", Alpha3B: "", Name: "Palauan"},
+	{Alpha2: "", Alpha3: "peo", Alpha3B: "", Name: "Persian, Old (ca. 600-400 B.C.)"},
+	{Alpha2: "", Alpha3: "phi", Alpha3B: "", Name: "Philippine languages"},
+	{Alpha2: "", Alpha3: "phn", Alpha3B: "", Name: "Phoenician"},
+	{Alpha2: "pi", Alpha3: "pli", Alpha3B: "", Name: "Pali"},
+	{Alpha2: "pl", Alpha3: "pol", Alpha3B: "", Name: "Polish"},
+	{Alpha2: "", Alpha3: "pon", Alpha3B: "", Name: "Pohnpeian"},
+	{Alpha2: "pt", Alpha3: "por", Alpha3B: "", Name: "Portuguese"},
+	{Alpha2: "", Alpha3: "pra", Alpha3B: "", Name: "Prakrit languages"},
+	{Alpha2: "", Alpha3: "pro", Alpha3B: "", Name: "Provençal, Old (to 1500)"},
+	{Alpha2: "ps", Alpha3: "pus", Alpha3B: "", Name: "Pushto; Pashto"},
+	{Alpha2: "qu", Alpha3: "que", Alpha3B: "", Name: "Quechua"},
+	{Alpha2: "", Alpha3: "raj", Alpha3B: "", Name: "Rajasthani"},
+	{Alpha2: "", Alpha3: "rap", Alpha3B: "", Name: "Rapanui"},
+	{Alpha2: "", Alpha3: "rar", Alpha3B: "", Name: "Rarotongan; Cook Islands Maori"},
+	{Alpha2: "", Alpha3: "roa", Alpha3B: "", Name: "Romance languages"},
+	{Alpha2: "rm", Alpha3: "roh", Alpha3B: "", Name: "Romansh"},
+	{Alpha2: "", Alpha3: "rom", Alpha3B: "", Name: "Romany"},
+	{Alpha2: "ro", Alpha3: "ron", Alpha3B: "rum", Name: "Romanian; Moldavian; Moldovan"},
+	{Alpha2: "rn", Alpha3: "run", Alpha3B: "", Name: "Rundi"},
+	{Alpha2: "", Alpha3: "rup", Alpha3B: "", Name: "Aromanian; Arumanian; Macedo-Romanian"},
+	{Alpha2: "ru", Alpha3: "rus", Alpha3B: "", Name: "Russian"},
+	{Alpha2: "", Alpha3: "sad", Alpha3B: "", Name: "Sandawe"},
+	{Alpha2: "sg", Alpha3: "sag", Alpha3B: "", Name: "Sango"},
+	{Alpha2: "", Alpha3: "sah", Alpha3B: "", Name: "Yakut"},
+	{Alpha2: "", Alpha3: "sai", Alpha3B: "", Name: "South American Indian (Other)"},
+	{Alpha2: "", Alpha3: "sal", Alpha3B: "", Name: "Salishan languages"},
+	{Alpha2: "", Alpha3: "sam", Alpha3B: "", Name: "Samaritan Aramaic"},
+	{Alpha2: "sa", Alpha3: "san", Alpha3B: "", Name: "Sanskrit"},
+	{Alpha2: "", Alpha3: "sas", Alpha3B: "", Name: "Sasak"},
+	{Alpha2: "", Alpha3: "sat", Alpha3B: "", Name: "Santali"},
+	{Alpha2: "", Alpha3: "scn", Alpha3B: "", Name: "Sicilian"},
+	{Alpha2: "", Alpha3: "sco", Alpha3B: "", Name: "Scots"},
+	{Alpha2: "", Alpha3: "sel", Alpha3B: "", Name: "Selkup"},
+	{Alpha2: "", Alpha3: "sem", Alpha3B: "", Name: "Semitic languages"},
+	{Alpha2: "", Alpha3: "sga", Alpha3B: "", Name: "Irish, Old (to 900)"},
+	{Alpha2: "", Alpha3: "sgn", Alpha3B: "", Name: "Sign Languages"},
+	{Alpha2: "", Alpha3: "shn", Alpha3B: "", Name: "Shan"},
+	{Alpha2: "", Alpha3: "sid", Alpha3B: "", Name: "Sidamo"},
+	{Alpha2: "si", Alpha3: "sin", Alpha3B: "", Name: "Sinhala; Sinhalese"},
+	{Alpha2: "", Alpha3: "sio", Alpha3B: "", Name: "Siouan languages"},
+	{Alpha2: "", Alpha3: "sit", Alpha3B: "", Name: "Sino-Tibetan languages"},
+	{Alpha2: "", Alpha3: "sla", Alpha3B: "", Name: "Slavic languages"},
+	{Alpha2: "sk", Alpha3: "slk", Alpha3B: "slo", Name: "Slovak"},
+	{Alpha2: "sl", Alpha3: "slv", Alpha3B: "", Name: "Slovenian"},
+	{Alpha2: "", Alpha3: "sma", Alpha3B: "", Name: "Southern Sami"},
+	{Alpha2: "se", Alpha3: "sme", Alpha3B: "", Name: "Northern Sami"},
+	{Alpha2: "", Alpha3: "smi", Alpha3B: "", Name: "Sami languages"},
+	{Alpha2: "", Alpha3: "smj", Alpha3B: "", Name: "Lule Sami"},
+	{Alpha2: "", Alpha3: "smn", Alpha3B: "", Name: "Inari Sami"},
+	{Alpha2: "sm", Alpha3: "smo", Alpha3B: "", Name: "Samoan"},
+	{Alpha2: "", Alpha3: "sms", Alpha3B: "", Name: "Skolt Sami"},
+	{Alpha2: "sn", Alpha3: "sna", Alpha3B: "", Name: "Shona"},
+	{Alpha2: "sd", Alpha3: "snd", Alpha3B: "", Name: "Sindhi"},
+	{Alpha2: "", Alpha3: "snk", Alpha3B: "", Name: "Soninke"},
+	{Alpha2: "", Alpha3: "sog", Alpha3B: "", Name: "Sogdian"},
+	{Alpha2: "so", Alpha3: "som", Alpha3B: "", Name: "Somali"},
+	{Alpha2: "", Alpha3: "son", Alpha3B: "", Name: "Songhai languages"},
+	{Alpha2: "st", Alpha3: "sot", Alpha3B: "", Name: "Sotho, Southern"},
+	{Alpha2: "es", Alpha3: "spa", Alpha3B: "", Name: "Spanish; Castilian"},
+	{Alpha2: "sq", Alpha3: "sqi", Alpha3B: "alb", Name: "Albanian"},
+	{Alpha2: "sc", Alpha3: "srd", Alpha3B: "", Name: "Sardinian"},
+	{Alpha2: "", Alpha3: "srn", Alpha3B: "", Name: "Sranan Tongo"},
+	{Alpha2: "sr", Alpha3: "srp", Alpha3B: "", Name: "Serbian"},
+	{Alpha2: "", Alpha3: "srr", Alpha3B: "", Name: "Serer"},
+	{Alpha2: "", Alpha3: "ssa", Alpha3B: "", Name: "Nilo-Saharan languages"},
+	{Alpha2: "ss", Alpha3: "ssw", Alpha3B: "", Name: "Swati"},
+	{Alpha2: "", Alpha3: "suk", Alpha3B: "", Name: "Sukuma"},
+	{Alpha2: "su", Alpha3: "sun", Alpha3B: "", Name: "Sundanese"},
+	{Alpha2: "", Alpha3: "sus", Alpha3B: "", Name: "Susu"},
+	{Alpha2: "", Alpha3: "sux", Alpha3B: "", Name: "Sumerian"},
+	{Alpha2: "sw", Alpha3: "swa", Alpha3B: "", Name: "Swahili"},
+	{Alpha2: "sv", Alpha3: "swe", Alpha3B: "", Name: "Swedish"},
+	{Alpha2: "", Alpha3: "syc", Alpha3B: "", Name: "Classical Syriac"},
+	{Alpha2: "", Alpha3: "syr", Alpha3B: "", Name: "Syriac"},
+	{Alpha2: "ty", Alpha3: "tah", Alpha3B: "", Name: "Tahitian"},
+	{Alpha2: "", Alpha3: "tai", Alpha3B: "", Name: "Tai languages"},
+	{Alpha2: "ta", Alpha3: "tam", Alpha3B: "", Name: "Tamil"},
+	{Alpha2: "tt", Alpha3: "tat", Alpha3B: "", Name: "Tatar"},
+	{Alpha2: "te", Alpha3: "tel", Alpha3B: "", Name: "Telugu"},
+	{Alpha2: "", Alpha3: "tem", Alpha3B: "", Name: "Timne"},
+	{Alpha2: "", Alpha3: "ter", Alpha3B: "", Name: "Tereno"},
+	{Alpha2: "", Alpha3: "tet", Alpha3B: "", Name: "Tetum"},
+	{Alpha2: "tg", Alpha3: "tgk", Alpha3B: "", Name: "Tajik"},
+	{Alpha2: "tl", Alpha3: "tgl", Alpha3B: "", Name: "Tagalog"},
+	{Alpha2: "th", Alpha3: "tha", Alpha3B: "", Name: "Thai"},
+	{Alpha2: "", Alpha3: "tig", Alpha3B: "", Name: "Tigre"},
+	{Alpha2: "ti", Alpha3: "tir", Alpha3B: "", Name: "Tigrinya"},
+	{Alpha2: "", Alpha3: "tiv", Alpha3B: "", Name: "Tiv"},
+	{Alpha2: "", Alpha3: "tkl", Alpha3B: "", Name: "Tokelau"},
+	{Alpha2: "", Alpha3: "tlh", Alpha3B: "", Name: "Klingon; tlhIngan-Hol"},
+	{Alpha2: "", Alpha3: "tli", Alpha3B: "", Name: "Tlingit"},
+	{Alpha2: "", Alpha3: "tmh", Alpha3B: "", Name: "Tamashek"},
+	{Alpha2: "", Alpha3: "tog", Alpha3B: "", Name: "Tonga (Nyasa)"},
+	{Alpha2: "to", Alpha3: "ton", Alpha3B: "", Name: "Tonga (Tonga Islands)"},
+	{Alpha2: "", Alpha3: "tpi", Alpha3B: "", Name: "Tok Pisin"},
+	{Alpha2: "", Alpha3: "tsi", Alpha3B: "", Name: "Tsimshian"},
+	{Alpha2: "tn", Alpha3: "tsn", Alpha3B: "", Name: "Tswana"},
+	{Alpha2: "ts", Alpha3: "tso", Alpha3B: "", Name: "Tsonga"},
+	{Alpha2: "tk", Alpha3: "tuk", Alpha3B: "", Name: "Turkmen"},
+	{Alpha2: "", Alpha3: "tum", Alpha3B: "", Name: "Tumbuka"},
+	{Alpha2: "", Alpha3: "tup", Alpha3B: "", Name: "Tupi languages"},
+	{Alpha2: "tr", Alpha3: "tur", Alpha3B: "", Name: "Turkish"},
+	{Alpha2: "", Alpha3: "tut", Alpha3B: "", Name: "Altaic languages"},
+	{Alpha2: "", Alpha3: "tvl", Alpha3B: "", Name: "Tuvalu"},
+	{Alpha2: "tw", Alpha3: "twi", Alpha3B: "", Name: "Twi"},
+	{Alpha2: "", Alpha3: "tyv", Alpha3B: "", Name: "Tuvinian"},
+	{Alpha2: "", Alpha3: "udm", Alpha3B: "", Name: "Udmurt"},
+	{Alpha2: "", Alpha3: "uga", Alpha3B: "", Name: "Ugaritic"},
+	{Alpha2: "ug", Alpha3: "uig", Alpha3B: "", Name: "Uighur; Uyghur"},
+	{Alpha2: "uk", Alpha3: "ukr", Alpha3B: "", Name: "Ukrainian"},
+	{Alpha2: "", Alpha3: "umb", Alpha3B: "", Name: "Umbundu"},
+	{Alpha2: "", Alpha3: "und", Alpha3B: "", Name: "Undetermined"},
+	{Alpha2: "ur", Alpha3: "urd", Alpha3B: "", Name: "Urdu"},
+	{Alpha2: "uz", Alpha3: "uzb", Alpha3B: "", Name: "Uzbek"},
+	{Alpha2: "", Alpha3: "vai", Alpha3B: "", Name: "Vai"},
+	{Alpha2: "ve", Alpha3: "ven", Alpha3B: "", Name: "Venda"},
+	{Alpha2: "vi", Alpha3: "vie", Alpha3B: "", Name: "Vietnamese"},
+	{Alpha2: "vo", Alpha3: "vol", Alpha3B: "", Name: "Volapük"},
+	{Alpha2: "", Alpha3: "vot", Alpha3B: "", Name: "Votic"},
+	{Alpha2: "", Alpha3: "wak", Alpha3B: "", Name: "Wakashan languages"},
+	{Alpha2: "", Alpha3: "wal", Alpha3B: "", Name: "Walamo"},
+	{Alpha2: "", Alpha3: "war", Alpha3B: "", Name: "Waray"},
+	{Alpha2: "", Alpha3: "was", Alpha3B: "", Name: "Washo"},
+	{Alpha2: "", Alpha3: "wen", Alpha3B: "", Name: "Sorbian languages"},
+	{Alpha2: "wa", Alpha3: "wln", Alpha3B: "", Name: "Walloon"},
+	{Alpha2: "wo", Alpha3: "wol", Alpha3B: "", Name: "Wolof"},
+	{Alpha2: "", Alpha3: "xal", Alpha3B: "", Name: "Kalmyk; Oirat"},
+	{Alpha2: "xh", Alpha3: "xho", Alpha3B: "", Name: "Xhosa"},
+	{Alpha2: "", Alpha3: "yao", Alpha3B: "", Name: "Yao"},
+	{Alpha2: "", Alpha3: "yap", Alpha3B: "", Name: "Yapese"},
+	{Alpha2: "yi", Alpha3: "yid", Alpha3B: "", Name: "Yiddish"},
+	{Alpha2: "yo", Alpha3: "yor", Alpha3B: "", Name: "Yoruba"},
+	{Alpha2: "", Alpha3: "ypk", Alpha3B: "", Name: "Yupik languages"},
+	{Alpha2: "", Alpha3: "zap", Alpha3B: "", Name: "Zapotec"},
+	{Alpha2: "", Alpha3: "zbl", Alpha3B: "", Name: "Blissymbols; Blissymbolics; Bliss"},
+	{Alpha2: "", Alpha3: "zen", Alpha3B: "", Name: "Zenaga"},
+	{Alpha2: "", Alpha3: "zgh", Alpha3B: "", Name: "Standard Moroccan Tamazight"},
+	{Alpha2: "za", Alpha3: "zha", Alpha3B: "", Name: "Zhuang; Chuang"},
+	{Alpha2: "zh", Alpha3: "zho", Alpha3B: "chi", Name: "Chinese"},
+	{Alpha2: "", Alpha3: "znd", Alpha3B: "", Name: "Zande languages"},
+	{Alpha2: "zu", Alpha3: "zul", Alpha3B: "", Name: "Zulu"},
+	{Alpha2: "", Alpha3: "zun", Alpha3B: "", Name: "Zuni"},
+	{Alpha2: "", Alpha3: "zxx", Alpha3B: "", Name: "No linguistic content; Not applicable"},
+	{Alpha2: "", Alpha3: "zza", Alpha3B: "", Name: "Zaza; Dimili; Dimli; Kirdki; Kirmanjki; Zazaki"},
+}
@@ -0,0 +1,43 @@
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestLookupCountry(t *testing.T) {
+	want := validator.Country{Alpha2: "JP", Alpha3: "JPN", Numeric: "392", Name: "Japan"}
+
+	for _, code := range []string{"JP", "JPN", "392"} {
+		got, ok := validator.LookupCountry(code)
+		if !ok {
+			t.Fatalf("want %v found, but not found", code)
+		}
+		if want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+	}
+
+	if _, ok := validator.LookupCountry("jp"); ok {
+		t.Error("want not found, but found")
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	want := validator.Language{Alpha2: "de", Alpha3: "deu", Alpha3B: "ger", Name: "German"}
+
+	for _, code := range []string{"de", "deu", "ger"} {
+		got, ok := validator.LookupLanguage(code)
+		if !ok {
+			t.Fatalf("want %v found, but not found", code)
+		}
+		if want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+	}
+
+	if _, ok := validator.LookupLanguage("DE"); ok {
+		t.Error("want not found, but found")
+	}
+}
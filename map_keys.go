@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// sortedMapKeys returns the keys of the map value in the sorted order, so the elements are visited deterministically.
+// See compareKeys for the order.
+func sortedMapKeys(val reflect.Value) []reflect.Value {
+	keys := val.MapKeys()
+	if len(keys) > 1 {
+		sort.Slice(keys, func(i, j int) bool {
+			return compareKeys(keys[i], keys[j], 0) < 0
+		})
+	}
+	return keys
+}
+
+// maxKeyPointerDepth is the maximum number of the pointers that compareKeys follows, so the cyclic keys terminate.
+const maxKeyPointerDepth = 8
+
+// compareKeys compares the map keys a and b of the same type, and returns -1, 0 or +1.
+// Strings, numbers and bools are in the natural order, and NaN comes first in the floats.
+// Pointers are compared by the values that they point to, up to maxKeyPointerDepth pointers.
+// Structs and arrays are compared element by element, and interfaces are compared by the type name first and then by the value.
+// Nil comes first. Channels, functions and unsafe pointers are equal unless nil, because their values cannot be compared stably.
+// The other keys are compared by their formatted strings. depth is the number of the pointers followed.
+func compareKeys(a, b reflect.Value, depth int) int {
+	switch a.Kind() {
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareInt64(a.Int(), b.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareUint64(a.Uint(), b.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		return compareFloat64(a.Float(), b.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		if c := compareFloat64(real(a.Complex()), real(b.Complex())); c != 0 {
+			return c
+		}
+		return compareFloat64(imag(a.Complex()), imag(b.Complex()))
+
+	case reflect.Bool:
+		switch {
+		case a.Bool() == b.Bool():
+			return 0
+		case b.Bool():
+			return -1
+		}
+		return 1
+
+	case reflect.Ptr, reflect.UnsafePointer, reflect.Chan, reflect.Func:
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		if a.Kind() != reflect.Ptr || depth >= maxKeyPointerDepth {
+			return 0
+		}
+		return compareKeys(a.Elem(), b.Elem(), depth+1)
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := compareKeys(a.Field(i), b.Field(i), depth); c != 0 {
+				return c
+			}
+		}
+		return 0
+
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if c := compareKeys(a.Index(i), b.Index(i), depth); c != 0 {
+				return c
+			}
+		}
+		return 0
+
+	case reflect.Interface:
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		ea, eb := a.Elem(), b.Elem()
+		if ea.Type() != eb.Type() {
+			if c := strings.Compare(ea.Type().String(), eb.Type().String()); c != 0 {
+				return c
+			}
+			return strings.Compare(fmt.Sprint(ea), fmt.Sprint(eb))
+		}
+		return compareKeys(ea, eb, depth)
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	case a == b:
+		return 0
+	case math.IsNaN(a) && !math.IsNaN(b):
+		return -1
+	case !math.IsNaN(a) && math.IsNaN(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,95 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestValidateVar_MapKeyOrder(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	testcases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "string",
+			value:    map[string]string{"b": "", "a": "", "c": "", "aa": ""},
+			expected: "[a]: '' does validate as 'required';[aa]: '' does validate as 'required';[b]: '' does validate as 'required';[c]: '' does validate as 'required'",
+		},
+		{
+			name:     "int",
+			value:    map[int]string{10: "", -1: "", 2: ""},
+			expected: "[-1]: '' does validate as 'required';[2]: '' does validate as 'required';[10]: '' does validate as 'required'",
+		},
+		{
+			name:     "float",
+			value:    map[float64]string{1.5: "", 0: "", -2: ""},
+			expected: "[-2]: '' does validate as 'required';[0]: '' does validate as 'required';[1.5]: '' does validate as 'required'",
+		},
+		{
+			name:     "bool",
+			value:    map[bool]string{true: "", false: ""},
+			expected: "[false]: '' does validate as 'required';[true]: '' does validate as 'required'",
+		},
+		{
+			name:     "struct",
+			value:    map[point]string{{2, 1}: "", {1, 2}: "", {1, 1}: ""},
+			expected: "[{1 1}]: '' does validate as 'required';[{1 2}]: '' does validate as 'required';[{2 1}]: '' does validate as 'required'",
+		},
+		{
+			name:     "interface",
+			value:    map[interface{}]string{"a": "", 2: "", 1: "", nil: ""},
+			expected: "[<nil>]: '' does validate as 'required';[1]: '' does validate as 'required';[2]: '' does validate as 'required';[a]: '' does validate as 'required'",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				assertValidationError(t, tc.expected, validator.ValidateVar(tc.value, ";required"))
+			}
+		})
+	}
+}
+
+func TestValidateVar_MapPointerKeyOrder(t *testing.T) {
+	type ring struct {
+		Next *ring
+		N    int
+	}
+
+	var got []string
+	v := validator.New(validator.WithFunc("record", func(_ context.Context, f validator.Field, _ validator.FuncOption) (bool, error) {
+		got = append(got, f.Value().String())
+		return true, nil
+	}))
+
+	one, two, three := 1, 2, 3
+	for i := 0; i < 10; i++ {
+		got = nil
+		if err := v.ValidateVar(map[*int]string{&three: "c", nil: "nil", &one: "a", &two: "b"}, ";record"); err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"nil", "a", "b", "c"}; !reflect.DeepEqual(want, got) {
+			t.Fatalf("want %v, but got %v", want, got)
+		}
+	}
+
+	// the cyclic keys are compared up to the limited depth.
+	a, b := &ring{N: 2}, &ring{N: 1}
+	a.Next, b.Next = a, b
+	got = nil
+	if err := v.ValidateVar(map[*ring]string{a: "a", b: "b"}, ";record"); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"b", "a"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
@@ -0,0 +1,170 @@
+package validator
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// Memo is a bounded LRU cache of the results of the validating functions.
+	// Use Memo.Adapter to memoize the results for the validator, or ContextMemoAdapter to memoize them per validation call.
+	// It is safe for concurrent use.
+	Memo struct {
+		mu    sync.Mutex
+		size  int
+		ll    *list.List
+		items map[memoKey]*list.Element
+	}
+
+	// memoKey is a key of the result. It consists of the tag function, the params, and the type, the length and
+	// the SHA-256 hash of the value. The value itself is not held, so the memory of an entry does not depend on the value.
+	memoKey struct {
+		fn     uint64
+		params string
+		typ    reflect.Type
+		n      int
+		hash   [sha256.Size]byte
+	}
+
+	memoEntry struct {
+		key   memoKey
+		valid bool
+	}
+
+	memoContextKey struct{}
+)
+
+// memoFuncID is the last id of the functions that are memoized.
+var memoFuncID uint64
+
+// NewMemo returns a Memo that holds up to size results. If size is 0 or less, it holds 1024 results.
+func NewMemo(size int) *Memo {
+	if size <= 0 {
+		size = 1024
+	}
+	return &Memo{
+		size:  size,
+		ll:    list.New(),
+		items: map[memoKey]*list.Element{},
+	}
+}
+
+// ContextWithMemo returns a context that has the Memo that ContextMemoAdapter uses.
+func ContextWithMemo(ctx context.Context, m *Memo) context.Context {
+	return context.WithValue(ctx, memoContextKey{}, m)
+}
+
+// Adapter returns an Adapter that memoizes the results of the validating function in m.
+// The results are keyed by the tag function, the params and the SHA-256 hash of the value, so each tag has its own results.
+// The values are not held, so the memory is bounded by the size regardless of the length of the values.
+// Only the values of strings, byte slices, numbers and bools are memoized, and the errors are not memoized.
+// The function must be pure, that is the result depends only on the value and the params, not on the other fields.
+// Use AdapterForTags to memoize the expensive tags only. Since Apply rebuilds the functions,
+// the results of the previous functions are not used after Apply, and they are evicted in time.
+func (m *Memo) Adapter() Adapter {
+	return func(fn Func) Func {
+		return memoize(fn, func(context.Context) *Memo { return m })
+	}
+}
+
+// ContextMemoAdapter returns an Adapter that memoizes the results of the validating function in the Memo of the context,
+// that is set by ContextWithMemo. The results are shared only in the validation calls with the same Memo.
+// If the context does not have a Memo, the results are not memoized. See Memo.Adapter for the keys.
+func ContextMemoAdapter() Adapter {
+	return func(fn Func) Func {
+		return memoize(fn, func(ctx context.Context) *Memo {
+			m, _ := ctx.Value(memoContextKey{}).(*Memo)
+			return m
+		})
+	}
+}
+
+// Len returns the number of the results.
+func (m *Memo) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ll.Len()
+}
+
+// memoize returns a function that memoizes the results of fn in the Memo that memo returns.
+func memoize(fn Func, memo func(context.Context) *Memo) Func {
+	id := atomic.AddUint64(&memoFuncID, 1)
+	return func(ctx context.Context, f Field, opt FuncOption) (bool, error) {
+		m := memo(ctx)
+		if m == nil {
+			return fn(ctx, f, opt)
+		}
+		b, ok := memoBytes(f.current)
+		if !ok {
+			return fn(ctx, f, opt)
+		}
+
+		key := memoKey{fn: id, params: strings.Join(opt.TagParams, "\x00"), typ: f.current.Type(), n: len(b), hash: sha256.Sum256(b)}
+		if valid, ok := m.get(key); ok {
+			return valid, nil
+		}
+		valid, err := fn(ctx, f, opt)
+		if err == nil {
+			m.add(key, valid)
+		}
+		return valid, err
+	}
+}
+
+func (m *Memo) get(key memoKey) (bool, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return false, false
+	}
+	m.ll.MoveToFront(e)
+	return e.Value.(*memoEntry).valid, true
+}
+
+func (m *Memo) add(key memoKey, valid bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		// the result is added by the other goroutine that validates the same value.
+		m.ll.MoveToFront(e)
+		return
+	}
+	m.items[key] = m.ll.PushFront(&memoEntry{key: key, valid: valid})
+	if m.ll.Len() > m.size {
+		e := m.ll.Back()
+		m.ll.Remove(e)
+		delete(m.items, e.Value.(*memoEntry).key)
+	}
+}
+
+// memoBytes returns the bytes that represent the value. It returns false if the value cannot be memoized.
+func memoBytes(v reflect.Value) ([]byte, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, false
+		}
+		return v.Bytes(), true
+	case reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendUint(nil, math.Float64bits(v.Float()), 16), true
+	}
+	return nil, false
+}
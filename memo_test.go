@@ -0,0 +1,146 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+// countingFunc returns a validating function that counts the calls. It is valid if the value is "ok" or 1.
+func countingFunc(calls *int32) validator.Func {
+	return func(_ context.Context, f validator.Field, opt validator.FuncOption) (bool, error) {
+		atomic.AddInt32(calls, 1)
+		if f.String() == "fail" {
+			return false, errors.New("unavailable")
+		}
+		return f.String() == "ok" || f.String() == "1", nil
+	}
+}
+
+func TestMemo_Adapter(t *testing.T) {
+	var calls, other int32
+	memo := validator.NewMemo(10)
+	v := validator.New(
+		validator.WithFunc("expensive", countingFunc(&calls)),
+		validator.WithFunc("other", countingFunc(&other)),
+		validator.WithTagAdapters(validator.AdapterForTags([]string{"expensive"}, memo.Adapter())),
+	)
+
+	values := make([]string, 100)
+	for i := range values {
+		values[i] = []string{"ok", "ng", "ok", "ok"}[i%4]
+	}
+	err := v.ValidateVar(values, ";expensive")
+	if es, ok := validator.ToErrors(err); !ok || len(es) != 25 {
+		t.Fatalf("want 25 errors, but got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("want 2 calls, but got %d", calls)
+	}
+
+	// the results are shared in the validator.
+	_ = v.ValidateVar(values, ";expensive,other")
+	if calls != 2 || other != 100 {
+		t.Errorf("want 2 and 100 calls, but got %d and %d", calls, other)
+	}
+
+	// the params and the types are the part of the key.
+	_ = v.ValidateVar("ok", "expensive(1)")
+	_ = v.ValidateVar("ok", "expensive(1)")
+	_ = v.ValidateVar(1, "expensive")
+	_ = v.ValidateVar("1", "expensive")
+	if calls != 5 {
+		t.Errorf("want 5 calls, but got %d", calls)
+	}
+
+	// the errors are not memoized.
+	for i := 0; i < 2; i++ {
+		assertValidationError(t, ": an internal error occurred in 'expensive': unavailable", v.ValidateVar("fail", "expensive"))
+	}
+	if calls != 7 {
+		t.Errorf("want 7 calls, but got %d", calls)
+	}
+	if memo.Len() != 5 {
+		t.Errorf("want 5 results, but got %d", memo.Len())
+	}
+}
+
+func TestMemo_LRU(t *testing.T) {
+	var calls int32
+	memo := validator.NewMemo(2)
+	v := validator.New(validator.WithFunc("expensive", countingFunc(&calls)), validator.WithAdapters(memo.Adapter()))
+
+	for _, s := range []string{"a", "b", "a", "c", "b", "a"} {
+		_ = v.ValidateVar(s, "expensive")
+	}
+	// a, b, (a), c evicts b, b evicts a, a evicts c.
+	if calls != 5 {
+		t.Errorf("want 5 calls, but got %d", calls)
+	}
+	if memo.Len() != 2 {
+		t.Errorf("want 2 results, but got %d", memo.Len())
+	}
+}
+
+func TestContextMemoAdapter(t *testing.T) {
+	var calls int32
+	v := validator.New(validator.WithFunc("expensive", countingFunc(&calls)), validator.WithAdapters(validator.ContextMemoAdapter()))
+	values := []string{"ok", "ok", "ok"}
+
+	for i := 0; i < 2; i++ {
+		ctx := validator.ContextWithMemo(context.Background(), validator.NewMemo(0))
+		if err := v.ValidateVarContext(ctx, values, ";expensive"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("want 2 calls, but got %d", calls)
+	}
+
+	// without Memo, the results are not memoized.
+	if err := v.ValidateVar(values, ";expensive"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 5 {
+		t.Errorf("want 5 calls, but got %d", calls)
+	}
+}
+
+func TestMemo_Parallel(t *testing.T) {
+	var calls int32
+	memo := validator.NewMemo(100)
+	v := validator.New(
+		validator.WithFunc("expensive", countingFunc(&calls)),
+		validator.WithAdapters(memo.Adapter()),
+		validator.WithParallelThreshold(10),
+	)
+
+	values := make([]string, 1000)
+	for i := range values {
+		values[i] = "ok"
+	}
+	if err := v.ValidateVar(values, ";expensive"); err != nil {
+		t.Fatal(err)
+	}
+	if calls < 1 || calls > 100 {
+		t.Errorf("want the results memoized, but got %d calls", calls)
+	}
+}
+
+func TestMemo_LongValue(t *testing.T) {
+	var calls int32
+	memo := validator.NewMemo(1)
+	v := validator.New(validator.WithFunc("expensive", countingFunc(&calls)), validator.WithAdapters(memo.Adapter()))
+
+	long := strings.Repeat("a", 1<<20)
+	for _, s := range []string{long, long, long + "a"} {
+		_ = v.ValidateVar(s, "expensive")
+	}
+	if calls != 2 {
+		t.Errorf("want 2 calls, but got %d", calls)
+	}
+}
@@ -0,0 +1,353 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	// ModFunc is the type of modifying function.
+	// It modifies the field value in place. e.g. f.Value().SetString(strings.TrimSpace(f.Value().String()))
+	ModFunc func(context.Context, Field, FuncOption) error
+
+	// ModFuncMap is the type of map of modifying functions.
+	ModFuncMap map[string]ModFunc
+
+	// ModError represents an error that occurs when a modifying function fails.
+	ModError struct {
+		// Field is a field name. e.g. Foo.Bar.Value
+		Field string
+
+		// Tag is a tag of the modifying function.
+		Tag Tag
+
+		// Err is an error that is returned by the modifying function.
+		Err error
+	}
+)
+
+var (
+	defaultModFuncMap = ModFuncMap{
+		"trim":  modifyString(trim),
+		"ltrim": modifyString(trimLeft),
+		"rtrim": modifyString(trimRight),
+		"lower": modifyString(lower),
+		"upper": modifyString(upper),
+		"kana":  modifyString(toFullWidthKana),
+		"nfc":   modifyString(toNFC),
+	}
+
+	halfWidthKana = []rune("｡｢｣､･ｦｧｨｩｪｫｬｭｮｯｰｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝﾞﾟ")
+	fullWidthKana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜")
+)
+
+// Error returns an error message string.
+func (e *ModError) Error() string {
+	return fmt.Sprintf("%s: an internal error occurred in '%s': %v", e.Field, e.Tag, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ModError) Unwrap() error {
+	return e.Err
+}
+
+// Normalize modifies a struct that uses the struct field's mod tag.
+// If a modifying function fails, it returns ModError.
+// The argument must be a pointer to a struct so that the fields are settable.
+func (v *Validator) Normalize(s interface{}) error {
+	return v.NormalizeContext(context.Background(), s)
+}
+
+// NormalizeContext modifies a struct that uses the struct field's mod tag.
+// Pass context to each modifying functions.
+func (v *Validator) NormalizeContext(ctx context.Context, s interface{}) error {
+	if s == nil {
+		return nil
+	}
+	value := reflect.ValueOf(s)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return fmt.Errorf("pointer to struct required")
+	}
+	c := v.load()
+	w := c.newWalker(ctx, value)
+	defer w.release()
+	return c.normalizeStruct(ctx, w, Field{origin: value, current: value})
+}
+
+// NormalizeAndValidate sets default values, modifies a struct that uses the struct field's mod tag, and then validates it.
+func (v *Validator) NormalizeAndValidate(s interface{}) error {
+	return v.NormalizeAndValidateContext(context.Background(), s)
+}
+
+// NormalizeAndValidateContext sets default values, modifies a struct that uses the struct field's mod tag, and then validates it.
+// Pass context to each modifying and validating functions.
+func (v *Validator) NormalizeAndValidateContext(ctx context.Context, s interface{}) error {
+	if err := v.SetDefaults(s); err != nil {
+		return err
+	}
+	if err := v.NormalizeContext(ctx, s); err != nil {
+		return err
+	}
+	return v.ValidateStructContext(ctx, s)
+}
+
+func (c *config) normalizeStruct(ctx context.Context, w *walker, field Field) error {
+	val := field.current
+	if val.Kind() == reflect.Interface || val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return fmt.Errorf("struct type required")
+	}
+
+	ref, ok, err := w.enter(Field{name: field.name, origin: field.origin, current: val})
+	if !ok {
+		return err
+	}
+	defer w.leave(ref)
+
+	if err := w.tick(ctx, field); err != nil {
+		return err
+	}
+
+	fieldCaches, err := c.loadModCaches(val)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(fieldCaches); i++ {
+		originField := val.Field(fieldCaches[i].index)
+		valueField := c.extractVar(originField)
+
+		if err := c.normalize(ctx, w, newFieldWithParent(fieldCaches[i].name, originField, valueField, field), fieldCaches[i].modChunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadModCaches returns the field caches of the struct value that have the mod tag or may contain a struct.
+// Unlike loadFieldCaches, the mod tag is parsed only for modifying, so the validation does not depend on it.
+func (c *config) loadModCaches(val reflect.Value) ([]fieldCache, error) {
+	valueType := val.Type()
+	fieldCaches, hasCache := c.modCache.Load(valueType)
+	if hasCache {
+		return fieldCaches, nil
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		typeField := valueType.Field(i)
+		if typeField.PkgPath != "" { // private field
+			continue
+		}
+		modTagValue := typeField.Tag.Get(c.modTagKey)
+		if !c.canValidate(modTagValue, c.extractVar(val.Field(i)).Kind()) {
+			continue
+		}
+		chunk, err := c.parseModTag(modTagValue)
+		if err != nil {
+			return nil, err
+		}
+		fieldCaches = append(fieldCaches, fieldCache{index: i, name: typeField.Name, modChunk: chunk})
+	}
+	c.modCache.Store(valueType, fieldCaches)
+
+	return fieldCaches, nil
+}
+
+func (c *config) normalize(ctx context.Context, w *walker, field Field, chunk *tagChunk) error {
+	if err := w.tick(ctx, field); err != nil {
+		return err
+	}
+
+	if chunk.IsOptional() && isEmpty(field) {
+		return nil
+	}
+
+	for _, tag := range chunk.GetTags() {
+		if err := tag.modifyFn(ctx, field, FuncOption{TagParams: tag.params, c: c}); err != nil {
+			return &ModError{Field: field.Name(), Tag: tag, Err: err}
+		}
+	}
+
+	var val = field.current
+	switch val.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		ref, ok, err := w.enter(field)
+		if !ok {
+			return err
+		}
+		defer w.leave(ref)
+
+		if err := w.visit(field, val.Len()); err != nil {
+			return err
+		}
+	}
+
+	switch val.Kind() {
+	case reflect.Map:
+		if chunk.Next == nil && !c.canValidate("", val.Type().Elem().Kind()) {
+			break
+		}
+		for _, k := range sortedMapKeys(val) {
+			// map elements are not settable so modify a copy and store it.
+			value := reflect.New(val.Type().Elem()).Elem()
+			value.Set(val.MapIndex(k))
+
+			err := c.normalize(ctx, w, newFieldWithParent(fmt.Sprintf("[%v]", k), value, c.extractVar(value), field), chunk.Next)
+			if err != nil {
+				return err
+			}
+			val.SetMapIndex(k, value)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if chunk.Next == nil && !c.canValidate("", val.Type().Elem().Kind()) {
+			break
+		}
+		for i := 0; i < val.Len(); i++ {
+			value := val.Index(i)
+
+			err := c.normalize(ctx, w, newFieldWithParent(fmt.Sprintf("[%d]", i), value, c.extractVar(value), field), chunk.Next)
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Struct:
+		err := c.normalizeStruct(ctx, w, newFieldWithParent("", field.origin, val, field))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modifyString returns a modifying function that modifies a string field.
+// A nil pointer is left as it is.
+func modifyString(fn func(string, []string) string) ModFunc {
+	return func(_ context.Context, f Field, opt FuncOption) error {
+		v := f.current
+		switch v.Kind() {
+		case reflect.Ptr, reflect.Interface:
+			if v.IsNil() {
+				return nil
+			}
+
+		case reflect.String:
+			if !v.CanSet() {
+				return fmt.Errorf("cannot set value")
+			}
+			v.SetString(fn(v.String(), opt.TagParams))
+			return nil
+		}
+		return fmt.Errorf("string type required")
+	}
+}
+
+// trim returns s with leading and trailing white spaces removed.
+// If the parameters are given, they are treated as cutsets. e.g. trim(-)
+func trim(s string, params []string) string {
+	if len(params) == 0 {
+		return strings.TrimSpace(s)
+	}
+	return strings.Trim(s, strings.Join(params, ""))
+}
+
+func trimLeft(s string, params []string) string {
+	if len(params) == 0 {
+		return strings.TrimLeftFunc(s, unicode.IsSpace)
+	}
+	return strings.TrimLeft(s, strings.Join(params, ""))
+}
+
+func trimRight(s string, params []string) string {
+	if len(params) == 0 {
+		return strings.TrimRightFunc(s, unicode.IsSpace)
+	}
+	return strings.TrimRight(s, strings.Join(params, ""))
+}
+
+func lower(s string, _ []string) string {
+	return strings.ToLower(s)
+}
+
+func upper(s string, _ []string) string {
+	return strings.ToUpper(s)
+}
+
+// toNFC returns s in Unicode Normalization Form C. e.g. "e\u0301" -> "\u00e9"
+func toNFC(s string, _ []string) string {
+	return norm.NFC.String(s)
+}
+
+// toFullWidthKana converts half-width katakana to full-width katakana.
+// A voiced or semi-voiced sound mark is combined with the preceding character. e.g. ｶﾞ -> ガ
+func toFullWidthKana(s string, _ []string) string {
+	src := []rune(s)
+	dst := make([]rune, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		r := src[i]
+		if r < halfWidthKana[0] || halfWidthKana[len(halfWidthKana)-1] < r {
+			dst = append(dst, r)
+			continue
+		}
+		r = fullWidthKana[r-halfWidthKana[0]]
+
+		if i+1 < len(src) {
+			switch src[i+1] {
+			case 'ﾞ':
+				switch {
+				case 'カ' <= r && r <= 'ト' && r != 'ッ', 'ハ' <= r && r <= 'ホ':
+					r++
+					i++
+				case r == 'ウ':
+					r = 'ヴ'
+					i++
+				case r == 'ワ':
+					r = 'ヷ'
+					i++
+				case r == 'ヲ':
+					r = 'ヺ'
+					i++
+				}
+
+			case 'ﾟ':
+				if 'ハ' <= r && r <= 'ホ' {
+					r += 2
+					i++
+				}
+			}
+		}
+		dst = append(dst, r)
+	}
+	return string(dst)
+}
+
+// Normalize modifies a struct that uses the struct field's mod tag using default validator.
+func Normalize(s interface{}) error {
+	return DefaultValidator().Normalize(s)
+}
+
+// NormalizeContext modifies a struct that uses the struct field's mod tag using default validator.
+// Pass context to each modifying functions.
+func NormalizeContext(ctx context.Context, s interface{}) error {
+	return DefaultValidator().NormalizeContext(ctx, s)
+}
+
+// NormalizeAndValidate sets default values, modifies a struct that uses the struct field's mod tag, and then validates it using default validator.
+func NormalizeAndValidate(s interface{}) error {
+	return DefaultValidator().NormalizeAndValidate(s)
+}
+
+// NormalizeAndValidateContext sets default values, modifies a struct that uses the struct field's mod tag, and then validates it using default validator.
+// Pass context to each modifying and validating functions.
+func NormalizeAndValidateContext(ctx context.Context, s interface{}) error {
+	return DefaultValidator().NormalizeAndValidateContext(ctx, s)
+}
@@ -0,0 +1,280 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestNormalize(t *testing.T) {
+	type (
+		Name struct {
+			First string `mod:"trim"`
+			Kana  string `mod:"trim,kana"`
+			Last  string `mod:"nfc"`
+		}
+
+		User struct {
+			Email    string            `mod:"trim,lower"`
+			Code     string            `mod:"trim(-),upper"`
+			Left     string            `mod:"ltrim"`
+			Right    string            `mod:"rtrim"`
+			Nickname *string           `mod:"trim"`
+			Nil      *string           `mod:"trim"`
+			Name     Name              // nested struct without tag
+			Names    []*Name           // slice of pointers
+			Tags     []string          `mod:";trim,lower"`
+			Labels   map[string]string `mod:";upper"`
+			Optional string            `mod:"optional,trim"`
+			Skip     string            `mod:"-"`
+			private  string
+		}
+	)
+
+	nickname := " gopher "
+	u := &User{
+		Email:    "  Gopher@Example.COM ",
+		Code:     "--abc--",
+		Left:     "  left  ",
+		Right:    "  right  ",
+		Nickname: &nickname,
+		Name:     Name{First: " Taro ", Kana: " ﾀﾛｳ ｶﾞｯｺｳ ﾊﾟﾝ ", Last: "Cafe\u0301 \u304b\u3099"},
+		Names:    []*Name{{First: " Hanako "}, nil},
+		Tags:     []string{" Go ", "VALIDATOR"},
+		Labels:   map[string]string{"a": "x", "b": "y"},
+		Skip:     " skip ",
+		private:  " private ",
+	}
+
+	if err := validator.Normalize(u); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := []struct {
+		name string
+		want string
+		got  string
+	}{
+		{"Email", "gopher@example.com", u.Email},
+		{"Code", "ABC", u.Code},
+		{"Left", "left  ", u.Left},
+		{"Right", "  right", u.Right},
+		{"Nickname", "gopher", *u.Nickname},
+		{"Name.First", "Taro", u.Name.First},
+		{"Name.Kana", "タロウ ガッコウ パン", u.Name.Kana},
+		{"Name.Last", "Caf\u00e9 \u304c", u.Name.Last},
+		{"Names[0].First", "Hanako", u.Names[0].First},
+		{"Tags[0]", "go", u.Tags[0]},
+		{"Tags[1]", "validator", u.Tags[1]},
+		{"Labels[a]", "X", u.Labels["a"]},
+		{"Labels[b]", "Y", u.Labels["b"]},
+		{"Skip", " skip ", u.Skip},
+		{"private", " private ", u.private},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.want != tc.got {
+				t.Errorf("want %q, but got %q", tc.want, tc.got)
+			}
+		})
+	}
+	if u.Nil != nil {
+		t.Errorf("want nil, but got %v", u.Nil)
+	}
+}
+
+func TestNormalize_Kana(t *testing.T) {
+	type Kana struct {
+		Value string `mod:"kana"`
+	}
+
+	testcases := []struct {
+		value string
+		want  string
+	}{
+		{"ｱｲｳｴｵ", "アイウエオ"},
+		{"ｶﾞｷﾞｸﾞｹﾞｺﾞ", "ガギグゲゴ"},
+		{"ﾀﾞﾁﾞﾂﾞﾃﾞﾄﾞ", "ダヂヅデド"},
+		{"ﾊﾞﾋﾟﾌﾞﾍﾟﾎﾞ", "バピブペボ"},
+		{"ｳﾞﾜﾞｦﾞ", "ヴヷヺ"},
+		{"ｯﾞｱﾞﾟ", "ッ゛ア゛゜"},
+		{"ｰ｡｢｣､･", "ー。「」、・"},
+		{"abcテスト", "abcテスト"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.value, func(t *testing.T) {
+			k := &Kana{Value: tc.value}
+			if err := validator.Normalize(k); err != nil {
+				t.Fatal(err)
+			}
+			if tc.want != k.Value {
+				t.Errorf("want %q, but got %q", tc.want, k.Value)
+			}
+		})
+	}
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	type (
+		Valid struct {
+			Value string `mod:"trim"`
+		}
+
+		UnknownMod struct {
+			Value string `mod:"unknown"`
+		}
+
+		NotString struct {
+			Value int `mod:"trim"`
+		}
+
+		Interface struct {
+			Value interface{} `mod:"trim"`
+		}
+	)
+
+	testcases := []struct {
+		name      string
+		s         interface{}
+		wantError string
+	}{
+		{"not pointer", Valid{}, "pointer to struct required"},
+		{"nil pointer", (*Valid)(nil), "pointer to struct required"},
+		{"not struct", new(string), "struct type required"},
+		{"unknown mod", &UnknownMod{}, "parse: mod unknown function not found"},
+		{"not string", &NotString{}, "Value: an internal error occurred in 'trim': string type required"},
+		{"not settable", &Interface{Value: " a "}, "Value: an internal error occurred in 'trim': cannot set value"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validator.New().Normalize(tc.s)
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+		})
+	}
+
+	t.Run("typed error", func(t *testing.T) {
+		err := validator.Normalize(&NotString{})
+
+		var modErr *validator.ModError
+		if !errors.As(err, &modErr) {
+			t.Fatalf("want *ModError, but got %T", err)
+		}
+		if want, got := "Value", modErr.Field; want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+		if want, got := "trim", modErr.Tag.Name(); want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+		if want, got := "string type required", modErr.Err.Error(); want != got {
+			t.Errorf("want %v, but got %v", want, got)
+		}
+	})
+}
+
+func TestValidateStruct_ForeignModTag(t *testing.T) {
+	// the mod key may be used by another package, so the validation must not parse it.
+	type User struct {
+		Name string `valid:"required" mod:"gomod-something"`
+	}
+
+	if err := validator.ValidateStruct(&User{Name: "gopher"}); err != nil {
+		t.Fatal(err)
+	}
+	assertValidationError(t, "Name: '' does validate as 'required'", validator.ValidateStruct(&User{}))
+
+	if err := validator.Normalize(&User{Name: "gopher"}); err == nil || err.Error() != "parse: mod gomod-something function not found" {
+		t.Errorf("want parse error, but got %v", err)
+	}
+}
+
+func TestNormalizeAndValidate(t *testing.T) {
+	type User struct {
+		Email string `mod:"trim,lower" valid:"required,email"`
+		Name  string `mod:"trim" valid:"required"`
+	}
+
+	u := &User{Email: " Gopher@Example.com ", Name: "gopher"}
+	if err := validator.NormalizeAndValidate(u); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := "gopher@example.com", u.Email; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+
+	err := validator.NormalizeAndValidateContext(context.Background(), &User{Email: " Gopher@Example.com ", Name: "   "})
+	assertValidationError(t, "Name: '' does validate as 'required'", err)
+}
+
+func TestWithModFunc(t *testing.T) {
+	type User struct {
+		Name string `mod:"prefix(@)"`
+	}
+
+	v := validator.New(
+		validator.WithModFunc("prefix", func(_ context.Context, f validator.Field, opt validator.FuncOption) error {
+			if !strings.HasPrefix(f.Value().String(), opt.TagParams[0]) {
+				f.Value().SetString(opt.TagParams[0] + f.Value().String())
+			}
+			return nil
+		}),
+	)
+
+	u := &User{Name: "gopher"}
+	if err := v.Normalize(u); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := "@gopher", u.Name; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestWithModFuncMap(t *testing.T) {
+	type User struct {
+		Name string `mod:"reverse"`
+	}
+
+	v := validator.New(
+		validator.WithModFuncMap(validator.ModFuncMap{
+			"reverse": func(_ context.Context, f validator.Field, _ validator.FuncOption) error {
+				r := []rune(f.Value().String())
+				for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+					r[i], r[j] = r[j], r[i]
+				}
+				f.Value().SetString(string(r))
+				return nil
+			},
+		}),
+	)
+
+	u := &User{Name: "gopher"}
+	if err := v.Normalize(u); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := "rehpog", u.Name; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestWithModTagKey(t *testing.T) {
+	type User struct {
+		Name string `sanitize:"trim" mod:"upper"`
+	}
+
+	u := &User{Name: " gopher "}
+	if err := validator.New(validator.WithModTagKey("sanitize")).Normalize(u); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := "gopher", u.Name; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Observer is an interface that observes the validations, e.g. for metrics and tracing.
+	// Unlike Adapter, it sees the field of each tag and the lifecycle of the whole validation. Set it by WithObserver.
+	// The methods are called from the goroutines that validate, so they must be safe for concurrent use.
+	Observer interface {
+		// OnValidateStart is called when ValidateStruct or ValidateVar starts to validate the value s.
+		OnValidateStart(ctx context.Context, s interface{})
+
+		// OnValidateEnd is called when the validation of the value s ends. err is the result of the validation.
+		OnValidateEnd(ctx context.Context, s interface{}, err error, d time.Duration)
+
+		// OnTag is called when the validating function of the tag returns for the field.
+		// The tags in the parameters of or are observed as the result of or.
+		OnTag(ctx context.Context, field Field, tag Tag, valid bool, err error, d time.Duration)
+	}
+)
+
+// WithObserver is a validator option that sets an observer. If not set, the validation has no overhead for observing.
+func WithObserver(o Observer) Option {
+	return func(v *Validator) {
+		v.pending.observer = o
+	}
+}
+
+// observeStart calls OnValidateStart of the observer, and returns the start time. It returns the zero time if no observer.
+func (c *config) observeStart(ctx context.Context, s interface{}) time.Time {
+	if c.observer == nil {
+		return time.Time{}
+	}
+	return startObserving(ctx, c.observer, s)
+}
+
+// observeEnd calls OnValidateEnd of the observer, and returns err as is.
+func (c *config) observeEnd(ctx context.Context, s interface{}, start time.Time, err error) error {
+	if c.observer == nil {
+		return err
+	}
+	return endObserving(ctx, c.observer, s, start, err)
+}
+
+func startObserving(ctx context.Context, o Observer, s interface{}) time.Time {
+	o.OnValidateStart(ctx, s)
+	return time.Now()
+}
+
+func endObserving(ctx context.Context, o Observer, s interface{}, start time.Time, err error) error {
+	o.OnValidateEnd(ctx, s, err, time.Since(start))
+	return err
+}
@@ -0,0 +1,97 @@
+package validator_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/utahta/go-validator"
+)
+
+// recorder is an observer that records the events.
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) add(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) OnValidateStart(_ context.Context, s interface{}) {
+	r.add("start %T", s)
+}
+
+func (r *recorder) OnValidateEnd(_ context.Context, s interface{}, err error, _ time.Duration) {
+	r.add("end %T %v", s, err != nil)
+}
+
+func (r *recorder) OnTag(_ context.Context, field validator.Field, tag validator.Tag, valid bool, err error, d time.Duration) {
+	if d < 0 {
+		r.add("negative duration")
+	}
+	r.add("%s %s %v %v", field.Name(), tag, valid, err)
+}
+
+func TestWithObserver(t *testing.T) {
+	type User struct {
+		Name string   `valid:"required,unique"`
+		Nick string   `valid:"optional,alpha"`
+		Tags []string `valid:";or(alpha|numeric)"`
+	}
+
+	store := &userStore{names: map[string]bool{"alice": true}}
+	r := &recorder{}
+	v := validator.New(validator.WithObserver(r), validator.WithAsyncFunc("unique", store.notTaken))
+
+	assertValidationError(t, "Name: 'alice' does validate as 'unique';Tags[1]: '-' does validate as 'or(alpha|numeric)'",
+		v.ValidateStruct(&User{Name: "alice", Tags: []string{"go", "-"}}))
+	if err := v.ValidateVar("gopher", "alpha"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"start *validator_test.User",
+		"Name required true <nil>",
+		"Tags[0] or(alpha|numeric) true <nil>",
+		"Tags[1] or(alpha|numeric) false <nil>",
+		"Name unique false <nil>",
+		"end *validator_test.User true",
+		"start string",
+		" alpha true <nil>",
+		"end string false",
+	}
+	if got := strings.Join(r.events, "\n"); got != strings.Join(expected, "\n") {
+		t.Errorf("want\n%s\nbut got\n%s", strings.Join(expected, "\n"), got)
+	}
+}
+
+func TestWithObserver_Parallel(t *testing.T) {
+	values := make([]string, 1000)
+	for i := range values {
+		values[i] = "gopher"
+	}
+
+	r := &recorder{}
+	v := validator.New(validator.WithObserver(r), validator.WithParallelThreshold(100))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := v.ValidateVar(values, ";alpha"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(r.events) != 4*(len(values)+2) {
+		t.Errorf("want %d events, but got %d", 4*(len(values)+2), len(r.events))
+	}
+}
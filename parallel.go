@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// validateParallel validates the elements of the slice, array or map of the field concurrently by the chunk.
+// The elements are split into contiguous ranges per goroutine, and the errors are merged in the order of the elements,
+// so the errors are identical to the serial validation.
+func (c *config) validateParallel(ctx context.Context, w *walker, field Field, chunk *tagChunk) (Errors, error) {
+	val := field.current
+	var keys []reflect.Value
+	if val.Kind() == reflect.Map {
+		keys = sortedMapKeys(val)
+	}
+	n := val.Len()
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+
+	type result struct {
+		w    *walker
+		errs Errors
+		err  error
+	}
+	results := make([]result, workers)
+	base := w.counts
+
+	var wg sync.WaitGroup
+	for i := range results {
+		results[i].w = w.fork()
+		wg.Add(1)
+		go func(r *result, lo, hi int) {
+			defer wg.Done()
+			for j := lo; j < hi; j++ {
+				var name string
+				var value reflect.Value
+				if keys != nil {
+					name, value = fmt.Sprintf("[%v]", keys[j]), val.MapIndex(keys[j])
+				} else {
+					name, value = fmt.Sprintf("[%d]", j), val.Index(j)
+				}
+
+				err := c.validate(ctx, r.w, newFieldWithParent(name, value, c.extractVar(value), field), chunk)
+				if err != nil {
+					if es, ok := err.(Errors); ok {
+						r.errs = append(r.errs, es...)
+					} else {
+						r.err = err
+						return
+					}
+				}
+			}
+		}(&results[i], n*i/workers, n*(i+1)/workers)
+	}
+	wg.Wait()
+
+	var (
+		errs     Errors
+		firstErr error
+	)
+	for i := range results {
+		r := &results[i]
+		w.join(r.w, base)
+		r.w.release()
+		if firstErr == nil {
+			firstErr = r.err
+		}
+		errs = append(errs, r.errs...)
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := w.check(field); err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
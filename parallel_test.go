@@ -0,0 +1,115 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+type (
+	batchItem struct {
+		ID    string            `valid:"required,alphanum"`
+		Email string            `valid:"email"`
+		Tags  []string          `valid:"max(3);min(1)"`
+		Attrs map[string]string `valid:";alpha"`
+		Batch *batch
+	}
+
+	batch struct {
+		Items []*batchItem `valid:"required"`
+	}
+)
+
+func newBatch(n int) *batch {
+	b := &batch{}
+	for i := 0; i < n; i++ {
+		item := &batchItem{
+			ID:    fmt.Sprintf("id%d", i),
+			Email: "gopher@example.com",
+			Tags:  []string{"a", "b"},
+			Attrs: map[string]string{"k": "v"},
+			Batch: b,
+		}
+		switch i % 7 {
+		case 1:
+			item.ID = ""
+		case 2:
+			item.Email = "gopher"
+		case 3:
+			item.Tags = []string{"a", "", "c", "d"}
+		case 4:
+			item.Attrs = map[string]string{"k": "1"}
+		}
+		b.Items = append(b.Items, item)
+	}
+	return b
+}
+
+func TestWithParallelThreshold(t *testing.T) {
+	b := newBatch(5000)
+
+	serial := validator.New().ValidateStruct(b)
+	if serial == nil {
+		t.Fatal("want errors, but got nil")
+	}
+	parallel := validator.New(validator.WithParallelThreshold(100)).ValidateStruct(b)
+	if parallel == nil || serial.Error() != parallel.Error() {
+		t.Errorf("want the same errors as the serial validation, but got %v", parallel)
+	}
+
+	m := map[string]*batchItem{}
+	for i, item := range newBatch(500).Items {
+		item.Batch = nil
+		m[fmt.Sprint(i)] = item
+	}
+	serial = validator.New().ValidateVar(m, ";")
+	parallel = validator.New(validator.WithParallelThreshold(100)).ValidateVar(m, ";")
+	if parallel == nil || serial.Error() != parallel.Error() {
+		t.Errorf("want the same errors as the serial validation, but got %v", parallel)
+	}
+
+	small := newBatch(10)
+	if want, got := validator.ValidateStruct(small), validator.New(validator.WithParallelThreshold(100)).ValidateStruct(small); want.Error() != got.Error() {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestWithParallelThreshold_Limits(t *testing.T) {
+	b := newBatch(1000)
+
+	err := validator.New(validator.WithParallelThreshold(100), validator.WithMaxErrors(50)).ValidateStruct(b)
+	var limitErr *validator.LimitError
+	if !errors.As(err, &limitErr) || limitErr.Limit != "errors" {
+		t.Errorf("want LimitError of errors, but got %v", err)
+	}
+
+	err = validator.New(validator.WithParallelThreshold(100), validator.WithMaxElements(3000)).ValidateStruct(b)
+	if !errors.As(err, &limitErr) || limitErr.Limit != "elements" {
+		t.Errorf("want LimitError of elements, but got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = validator.New(validator.WithParallelThreshold(100)).ValidateStructContext(ctx, b)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, but got %v", err)
+	}
+}
+
+func TestWithParallelThreshold_Async(t *testing.T) {
+	store := &userStore{names: map[string]bool{"id3": true, "id700": true}}
+	v := validator.New(
+		validator.WithParallelThreshold(100),
+		validator.WithAsyncFunc("unique", store.notTaken),
+		validator.WithMaxAsyncWorkers(16),
+	)
+
+	var ids []string
+	for i := 0; i < 1000; i++ {
+		ids = append(ids, fmt.Sprintf("id%d", i))
+	}
+	assertValidationError(t, "[3]: 'id3' does validate as 'unique';[700]: 'id700' does validate as 'unique'", v.ValidateVar(ids, ";unique"))
+}
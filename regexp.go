@@ -1,6 +1,9 @@
 package validator
 
-import "regexp"
+import (
+	"reflect"
+	"regexp"
+)
 
 const (
 	alphaRegexString               = "^[a-zA-Z]+$"
@@ -32,10 +35,22 @@ const (
 	longitudeRegexString           = `^[-+]?(180(\.0+)?|((1[0-7]\d)|([1-9]?\d))(\.\d+)?)$`
 	ssnRegexString                 = `^\d{3}[- ]?\d{2}[- ]?\d{4}$`
 	semverRegexString              = `^v?(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)\.(?:0|[1-9]\d*)(-(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(\.(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?(\+[0-9a-zA-Z-]+(\.[0-9a-zA-Z-]+)*)?$`
-	katakanaRegexString            = `^[\p{Katakana}]+$`
-	hiraganaRegexString            = `^[\p{Hiragana}]+$`
+	katakanaRegexString            = `^[\p{Katakana}\x{30FC}\x{FF70}\x{FF9E}\x{FF9F}]+$`
+	hiraganaRegexString            = `^[\p{Hiragana}\x{30FC}]+$`
 	fullWidthRegexString           = "[^\u0020-\u007E\uFF61-\uFF9F\uFFA0-\uFFDC\uFFE8-\uFFEE0-9a-zA-Z]"
 	halfWidthRegexString           = "[\u0020-\u007E\uFF61-\uFF9F\uFFA0-\uFFDC\uFFE8-\uFFEE0-9a-zA-Z]"
+	fullWidthKatakanaRegexString   = `^[\x{30A1}-\x{30FA}\x{30FC}-\x{30FF}]+$`
+	halfWidthKatakanaRegexString   = `^[\x{FF66}-\x{FF9F}]+$`
+	hostnameRegexString            = `^(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9])(?:\.(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]))*$`
+	fqdnRegexString                = `^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9])\.)+(?:[a-zA-Z]|[a-zA-Z][a-zA-Z0-9-]{0,61}[a-zA-Z0-9])$`
+	ibanRegexString                = `^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`
+	bicRegexString                 = `^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`
+	jpZipCodeRegexString           = `^[0-9]{3}-?[0-9]{4}$`
+	jpPhoneRegexString             = `^(?:0[0-9]{9,10}|0[0-9]{1,4}-[0-9]{1,4}-[0-9]{3,4})$`
+	jpPhoneLandlineRegexString     = `^0[0-9]{1,4}-[0-9]{1,4}-[0-9]{4}$`
+	jpPhoneMobileRegexString       = `^0[0-9]0-[0-9]{4}-[0-9]{4}$`
+	jpPhoneTollFree0120RegexString = `^0120-[0-9]{3}-[0-9]{3}$`
+	jpPhoneTollFree0800RegexString = `^0800-[0-9]{3}-[0-9]{4}$`
 )
 
 var (
@@ -72,4 +87,79 @@ var (
 	hiraganaRegex            = regexp.MustCompile(hiraganaRegexString)
 	fullWidthRegex           = regexp.MustCompile(fullWidthRegexString)
 	halfWidthRegex           = regexp.MustCompile(halfWidthRegexString)
+	fullWidthKatakanaRegex   = regexp.MustCompile(fullWidthKatakanaRegexString)
+	halfWidthKatakanaRegex   = regexp.MustCompile(halfWidthKatakanaRegexString)
+	hostnameRegex            = regexp.MustCompile(hostnameRegexString)
+	fqdnRegex                = regexp.MustCompile(fqdnRegexString)
+	ibanRegex                = regexp.MustCompile(ibanRegexString)
+	bicRegex                 = regexp.MustCompile(bicRegexString)
+	jpZipCodeRegex           = regexp.MustCompile(jpZipCodeRegexString)
+	jpPhoneRegex             = regexp.MustCompile(jpPhoneRegexString)
+	jpPhoneLandlineRegex     = regexp.MustCompile(jpPhoneLandlineRegexString)
+	jpPhoneMobileRegex       = regexp.MustCompile(jpPhoneMobileRegexString)
+	jpPhoneTollFree0120Regex = regexp.MustCompile(jpPhoneTollFree0120RegexString)
+	jpPhoneTollFree0800Regex = regexp.MustCompile(jpPhoneTollFree0800RegexString)
+)
+
+// regexFuncs is a set of the built-in validating functions that scan the value by the regular expressions.
+// see WithMaxRegexBytes. The functions are identified by their code pointers, so a function registered by WithFunc
+// is not counted even if its tag name is the same as a built-in one.
+var regexFuncs = funcSet(
+	isAlpha,
+	isAlphaNum,
+	isAlphaUnicode,
+	isAlphaNumUnicode,
+	isNumeric,
+	isNumber,
+	isHexadecimal,
+	isHexcolor,
+	isRGB,
+	isRGBA,
+	isHSL,
+	isHSLA,
+	isEmail,
+	isBase64,
+	isBase64URL,
+	isISBN10,
+	isISBN13,
+	isUUID,
+	isUUID3,
+	isUUID4,
+	isUUID5,
+	isASCII,
+	isPrintableASCII,
+	isMultibyte,
+	isDataURI,
+	isLatitude,
+	isLongitude,
+	isSSN,
+	isSemver,
+	isKatakana,
+	isHiragana,
+	isFullWidth,
+	isHalfWidth,
+	isHostname,
+	isFQDN,
+	isPort,
+	isIBAN,
+	isBIC,
+	isFullWidthKatakana,
+	isHalfWidthKatakana,
+	isJPZipCode,
+	isJPPhoneNumber,
 )
+
+// funcSet returns a set of the code pointers of the functions.
+func funcSet(fns ...Func) map[uintptr]struct{} {
+	set := make(map[uintptr]struct{}, len(fns))
+	for _, fn := range fns {
+		set[reflect.ValueOf(fn).Pointer()] = struct{}{}
+	}
+	return set
+}
+
+// scansRegex returns true if fn is a built-in validating function that scans the value by a regular expression.
+func scansRegex(fn Func) bool {
+	_, ok := regexFuncs[reflect.ValueOf(fn).Pointer()]
+	return ok
+}
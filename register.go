@@ -0,0 +1,151 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// RegisterErrors represents the problems found in the struct types by Register.
+	RegisterErrors []error
+)
+
+// Error returns an error message string.
+func (es RegisterErrors) Error() string {
+	var s []string
+	for _, e := range es {
+		s = append(s, e.Error())
+	}
+	return strings.Join(s, ";")
+}
+
+// Register parses all tags of the struct types and the struct types nested in their fields, slices, maps and pointers,
+// and stores them to the caches, so that invalid tags are found at startup instead of the first validation.
+// The types may be structs or pointers to structs. e.g. v.Register(User{}, &Address{})
+// It returns RegisterErrors that contains all problems. The struct types that have problems are not cached.
+func (v *Validator) Register(types ...interface{}) error {
+	c := v.load()
+
+	var errs RegisterErrors
+	visited := map[reflect.Type]struct{}{}
+	for _, s := range types {
+		t, err := structType(s)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		errs = c.registerType(t, visited, errs)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// MustRegister is like Register but panics if there are problems.
+func (v *Validator) MustRegister(types ...interface{}) {
+	if err := v.Register(types...); err != nil {
+		panic(err)
+	}
+}
+
+// registerType parses all tags of the type and its nested types, stores them to the caches, and appends the problems to errs.
+func (c *config) registerType(t reflect.Type, visited map[reflect.Type]struct{}, errs RegisterErrors) RegisterErrors {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return c.registerType(t.Elem(), visited, errs)
+
+	case reflect.Struct:
+		if _, ok := visited[t]; ok {
+			return errs
+		}
+		visited[t] = struct{}{}
+
+		n := len(errs)
+		errs = c.checkFields(t, errs)
+		if len(errs) == n {
+			if _, err := c.loadFieldCaches(reflect.New(t).Elem()); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %v", t, err))
+			}
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			errs = c.registerType(t.Field(i).Type, visited, errs)
+		}
+	}
+	return errs
+}
+
+// checkFields checks the tags of each field of the struct type, and appends the problems to errs.
+func (c *config) checkFields(t reflect.Type, errs RegisterErrors) RegisterErrors {
+	rules := c.rules.Load(t)
+
+	val := reflect.New(t).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		typeField := t.Field(i)
+		if typeField.PkgPath != "" {
+			continue
+		}
+
+		tagValue := typeField.Tag.Get(c.tagKey)
+		if rawTag, ok := rules[typeField.Name]; ok {
+			tagValue = rawTag
+		}
+
+		kind := c.extractVar(val.Field(i)).Kind()
+		if c.canValidate(tagValue, kind) {
+			chunk, err := c.parseTag(tagValue)
+			if err == nil {
+				err = c.compileChunk(chunk)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s: %v", t, typeField.Name, err))
+			}
+		}
+
+		if modTagValue := typeField.Tag.Get(c.modTagKey); c.canValidate(modTagValue, kind) {
+			if _, err := c.parseModTag(modTagValue); err != nil {
+				errs = append(errs, fmt.Errorf("%s.%s: %v", t, typeField.Name, err))
+			}
+		}
+
+		if defaultValue, ok := typeField.Tag.Lookup(c.defaultTagKey); ok {
+			if err := setDefaultValue(reflect.New(typeField.Type).Elem(), defaultValue); err != nil {
+				errs = append(errs, &DefaultValueError{Field: t.String() + "." + typeField.Name, Value: defaultValue, Type: typeField.Type, Err: err})
+			}
+		}
+	}
+	return errs
+}
+
+// compileChunk parses the tags in the parameters of `or` tag.
+func (c *config) compileChunk(chunk *tagChunk) error {
+	for ch := chunk; ch != nil; ch = ch.Next {
+		for _, tag := range ch.Tags {
+			if tag.name != "or" {
+				continue
+			}
+			for _, rawTag := range tag.params {
+				if _, err := c.parseTag(rawTag); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Register parses all tags of the struct types using default validator. see Validator.Register.
+func Register(types ...interface{}) error {
+	return DefaultValidator().Register(types...)
+}
+
+// MustRegister is like Register but panics if there are problems.
+func MustRegister(types ...interface{}) {
+	DefaultValidator().MustRegister(types...)
+}
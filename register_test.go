@@ -0,0 +1,84 @@
+package validator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestRegister(t *testing.T) {
+	type (
+		Address struct {
+			Country string `valid:"required,country"`
+		}
+
+		User struct {
+			Name      string `valid:"required" mod:"trim" default:"gopher"`
+			Addresses []*Address
+		}
+	)
+
+	v := validator.New()
+	if err := v.Register(&User{}); err != nil {
+		t.Fatalf("want err nil, but got %v", err)
+	}
+	assertValidationError(t, "Addresses[0].Country: 'jp' does validate as 'country'", v.ValidateStruct(&User{
+		Name:      "gopher",
+		Addresses: []*Address{{Country: "jp"}},
+	}))
+
+	if err := validator.Register(User{}); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+}
+
+func TestRegister_Invalid(t *testing.T) {
+	type (
+		Address struct {
+			Country string `valid:"required,contry"`
+			Zip     string `valid:"or(jp_zipcode|zip)"`
+		}
+
+		User struct {
+			Name      string `valid:"requried"`
+			Email     string `mod:"lowr"`
+			Port      int    `default:"http"`
+			Addresses []*Address
+			Valid     string `valid:"required"`
+		}
+	)
+
+	err := validator.New().Register(&User{}, "", &User{})
+	const want = "validator_test.User.Name: parse: tag requried function not found;" +
+		"validator_test.User.Email: parse: mod lowr function not found;" +
+		`validator_test.User.Port: cannot set default value 'http' to int: strconv.ParseInt: parsing "http": invalid syntax;` +
+		"validator_test.Address.Country: parse: tag contry function not found;" +
+		"validator_test.Address.Zip: parse: tag zip function not found;" +
+		"struct type required"
+	if err == nil || err.Error() != want {
+		t.Fatalf("want `%v`, but got `%v`", want, err)
+	}
+
+	es, ok := err.(validator.RegisterErrors)
+	if !ok {
+		t.Fatalf("want RegisterErrors, but got %T", err)
+	}
+	var defaultErr *validator.DefaultValueError
+	if !errors.As(es[2], &defaultErr) {
+		t.Errorf("want *DefaultValueError, but got %T", es[2])
+	}
+}
+
+func TestMustRegister(t *testing.T) {
+	type User struct {
+		Name string `valid:"requried"`
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("want panic, but not")
+		}
+	}()
+	validator.New().MustRegister(User{})
+}
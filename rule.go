@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+type (
+	// RuleBuilder registers validation rules of the struct fields without struct field's tags.
+	// It is useful for the types that cannot have tags. e.g. third-party packages, protobuf-generated structs.
+	RuleBuilder struct {
+		v   *Validator
+		typ reflect.Type
+		err error
+	}
+
+	// ruleSet represents a set of programmatic field rules per struct type.
+	ruleSet struct {
+		mux sync.RWMutex
+		m   map[reflect.Type]map[string]string
+	}
+)
+
+// Rules returns a RuleBuilder for the type of the struct s. s may be a struct or a pointer to a struct.
+//
+//	err := v.Rules(&User{}).
+//		Field("Email", "required,email").
+//		Field("Age", "min(0),max(150)").
+//		Err()
+func (v *Validator) Rules(s interface{}) *RuleBuilder {
+	t, err := structType(s)
+	return &RuleBuilder{v: v, typ: t, err: err}
+}
+
+// Field registers the rule of the field. The rule has the same syntax as the struct field's tag.
+// It takes precedence over the struct field's tag, and `-` disables validating the field.
+// If an error has already occurred, it does nothing.
+func (b *RuleBuilder) Field(name, rawTag string) *RuleBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	// hold the lock so that the configuration is not changed until the rule is registered.
+	b.v.mux.Lock()
+	defer b.v.mux.Unlock()
+
+	c := b.v.load()
+	if err := c.checkRule(b.typ, name, rawTag); err != nil {
+		b.err = err
+		return b
+	}
+
+	rules := map[string]string{}
+	for k, r := range c.rules.Load(b.typ) {
+		rules[k] = r
+	}
+	rules[name] = rawTag
+
+	fieldCaches, err := c.buildFieldCaches(reflect.New(b.typ).Elem(), rules)
+	if err != nil {
+		b.err = fmt.Errorf("%s: %v", b.typ, err)
+		return b
+	}
+
+	// swap the configuration that has the new rule and cache as LoadRules does, so the validations in progress keep using the previous one.
+	nc := *c
+	nc.rules = c.rules.clone()
+	nc.rules.Store(b.typ, rules)
+	nc.structCache = c.structCache.clone(map[reflect.Type][]fieldCache{b.typ: fieldCaches})
+	b.v.current.Store(&nc)
+	return b
+}
+
+// Err returns the first error that occurred while registering the rules.
+func (b *RuleBuilder) Err() error {
+	return b.err
+}
+
+// checkRule checks that the struct type has the exported field and the rule is parsable.
+func (c *config) checkRule(t reflect.Type, name, rawTag string) error {
+	if f, ok := t.FieldByName(name); !ok || len(f.Index) != 1 || f.PkgPath != "" {
+		return fmt.Errorf("%s: field %s not found", t, name)
+	}
+
+	if rawTag == "" || rawTag == "-" {
+		return nil
+	}
+	chunk, err := c.parseTag(rawTag)
+	if err == nil {
+		err = c.compileChunk(chunk)
+	}
+	if err != nil {
+		return fmt.Errorf("%s.%s: %v", t, name, err)
+	}
+	return nil
+}
+
+// structType returns the struct type of s. s may be a struct or a pointer to a struct.
+func structType(s interface{}) (reflect.Type, error) {
+	t := reflect.TypeOf(s)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("struct type required")
+	}
+	return t, nil
+}
+
+func newRuleSet() *ruleSet {
+	return &ruleSet{m: map[reflect.Type]map[string]string{}}
+}
+
+// Load returns the rules of the struct type. The returned map must not be modified.
+func (rs *ruleSet) Load(t reflect.Type) map[string]string {
+	rs.mux.RLock()
+	defer rs.mux.RUnlock()
+	return rs.m[t]
+}
+
+// Store stores the rules of the struct type.
+func (rs *ruleSet) Store(t reflect.Type, rules map[string]string) {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+	rs.m[t] = rules
+}
+
+// clone returns a copy of the rule set.
+func (rs *ruleSet) clone() *ruleSet {
+	rs.mux.RLock()
+	defer rs.mux.RUnlock()
+
+	c := newRuleSet()
+	for t, rules := range rs.m {
+		c.m[t] = rules
+	}
+	return c
+}
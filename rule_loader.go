@@ -0,0 +1,175 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type (
+	// ruleDocument represents a rule document. type name -> field path -> tag string.
+	ruleDocument map[string]map[string]string
+
+	// loadedRule represents a rule that is resolved to the struct type and the field.
+	loadedRule struct {
+		typ    reflect.Type
+		name   string
+		rawTag string
+	}
+)
+
+// LoadRules loads the rules of the struct fields from the JSON document and registers them like Rules.
+// The document maps a type name to a map of a field path to a tag string.
+// The type name is either the name of the type or the package qualified name, and the types must be given as types.
+// A field path may refer to the field of a nested struct, and then the rule applies to the nested struct type.
+//
+//	{
+//	  "User": {
+//	    "Email": "required,email",
+//	    "Address.Zip": "jp_zipcode"
+//	  }
+//	}
+//
+// Note that a rule of a field path is not scoped to the parent type: "Address.Zip" above changes the validation of
+// the Zip field of the Address type wherever it is validated, including the other types that have an Address.
+// Therefore, it is an error that the field paths resolved to the same field have different tag strings,
+// e.g. "Shipping.Zip" and "Billing.Zip" of the same Address type.
+//
+// All rules are checked and the caches are built before any of them is registered, and then they are registered at once,
+// so no rule is registered if an error occurred, and the validations never see a part of the rules.
+func (v *Validator) LoadRules(r io.Reader, types ...interface{}) error {
+	var doc ruleDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("rules: %v", err)
+	}
+
+	typeMap := map[string][]reflect.Type{}
+	for _, s := range types {
+		t, err := structType(s)
+		if err != nil {
+			return fmt.Errorf("rules: %v", err)
+		}
+		typeMap[t.Name()] = append(typeMap[t.Name()], t)
+		if t.String() != t.Name() {
+			typeMap[t.String()] = append(typeMap[t.String()], t)
+		}
+	}
+
+	typeNames := make([]string, 0, len(doc))
+	for typeName := range doc {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	// hold the lock so that the configuration is not changed until the rules are registered.
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	cur := v.load()
+	var rules []loadedRule
+	resolved := map[loadedRule]loadedRule{} // the field without the tag string -> the rule of the field path resolved to it.
+	for _, typeName := range typeNames {
+		ts := typeMap[typeName]
+		switch {
+		case len(ts) == 0:
+			return fmt.Errorf("rules: unknown type %s", typeName)
+		case len(ts) > 1:
+			return fmt.Errorf("rules: ambiguous type %s", typeName)
+		}
+
+		paths := make([]string, 0, len(doc[typeName]))
+		for path := range doc[typeName] {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			rule, err := cur.resolveRule(ts[0], path, doc[typeName][path])
+			if err != nil {
+				return fmt.Errorf("rules: %v", err)
+			}
+
+			// the same rule of the field may be written in several paths, but different ones conflict.
+			key := loadedRule{typ: rule.typ, name: rule.name}
+			if prev, ok := resolved[key]; ok {
+				if prev.rawTag != rule.rawTag {
+					return fmt.Errorf("rules: %s.%s conflicts with %s: %s.%s has different rules", typeName, path, prev.name, rule.typ, rule.name)
+				}
+				continue
+			}
+			resolved[key] = loadedRule{typ: rule.typ, name: typeName + "." + path, rawTag: rule.rawTag}
+			rules = append(rules, rule)
+		}
+	}
+
+	typeRules := map[reflect.Type]map[string]string{}
+	for _, rule := range rules {
+		if _, ok := typeRules[rule.typ]; !ok {
+			typeRules[rule.typ] = map[string]string{}
+			for k, r := range cur.rules.Load(rule.typ) {
+				typeRules[rule.typ][k] = r
+			}
+		}
+		typeRules[rule.typ][rule.name] = rule.rawTag
+	}
+
+	typeCaches := make(map[reflect.Type][]fieldCache, len(typeRules))
+	for t, rules := range typeRules {
+		fieldCaches, err := cur.buildFieldCaches(reflect.New(t).Elem(), rules)
+		if err != nil {
+			return fmt.Errorf("rules: %s: %v", t, err)
+		}
+		typeCaches[t] = fieldCaches
+	}
+
+	// swap the configuration that has the new rules and caches, so the validations in progress keep using the previous one.
+	c := *cur
+	c.rules = cur.rules.clone()
+	for t, rules := range typeRules {
+		c.rules.Store(t, rules)
+	}
+	c.structCache = cur.structCache.clone(typeCaches)
+	v.current.Store(&c)
+	return nil
+}
+
+// LoadRulesFile loads the rules of the struct fields from the JSON file. see LoadRules.
+func (v *Validator) LoadRulesFile(name string, types ...interface{}) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("rules: %v", err)
+	}
+	defer f.Close()
+
+	return v.LoadRules(f, types...)
+}
+
+// resolveRule resolves the field path to the struct type that has the field, and checks the tag string.
+func (c *config) resolveRule(t reflect.Type, path, rawTag string) (loadedRule, error) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		f, ok := t.FieldByName(name)
+		if !ok || len(f.Index) != 1 || f.PkgPath != "" {
+			return loadedRule{}, fmt.Errorf("%s: field %s not found", t, name)
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array || ft.Kind() == reflect.Map {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			return loadedRule{}, fmt.Errorf("%s: field %s is not a struct", t, name)
+		}
+		t = ft
+	}
+
+	name := names[len(names)-1]
+	if err := c.checkRule(t, name, rawTag); err != nil {
+		return loadedRule{}, err
+	}
+	return loadedRule{typ: t, name: name, rawTag: rawTag}, nil
+}
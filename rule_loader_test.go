@@ -0,0 +1,182 @@
+package validator_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+type (
+	ruleAddress struct {
+		Zip string
+	}
+
+	ruleUser struct {
+		Email     string
+		Age       int
+		Address   *ruleAddress
+		Addresses []ruleAddress
+		Nick      string
+	}
+
+	ruleWrong struct {
+		Name string
+		Code string `valid:"unknown"`
+	}
+)
+
+func TestLoadRules(t *testing.T) {
+	// the rule of ruleAddress.Zip may be written in several paths if they are the same.
+	const doc = `{
+  "ruleUser": {
+    "Email": "required,email",
+    "Age": "max(150)",
+    "Address.Zip": "required,jp_zipcode"
+  },
+  "validator_test.ruleAddress": {
+    "Zip": "required,jp_zipcode"
+  }
+}`
+
+	v := validator.New()
+	if err := v.LoadRules(strings.NewReader(doc), &ruleUser{}, ruleAddress{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := v.ValidateStruct(&ruleUser{Email: "gopher@example.com", Address: &ruleAddress{Zip: "100-0001"}})
+	if err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	err = v.ValidateStruct(&ruleUser{Email: "gopher", Age: 151, Address: &ruleAddress{Zip: "1000"}, Addresses: []ruleAddress{{}}})
+	assertValidationError(t, "Email: 'gopher' does validate as 'email';Age: '151' does validate as 'max(150)';Address.Zip: '1000' does validate as 'jp_zipcode';Addresses[0].Zip: '' does validate as 'required';Addresses[0].Zip: '' does validate as 'jp_zipcode'", err)
+}
+
+func TestLoadRulesFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "rules.json")
+	if err := os.WriteFile(name, []byte(`{"ruleUser": {"Nick": "required"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	v := validator.New()
+	if err := v.LoadRulesFile(name, ruleUser{}); err != nil {
+		t.Fatal(err)
+	}
+	assertValidationError(t, "Nick: '' does validate as 'required'", v.ValidateStruct(&ruleUser{}))
+
+	if err := v.LoadRulesFile(filepath.Join(t.TempDir(), "notfound.json"), ruleUser{}); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
+
+func TestLoadRules_Invalid(t *testing.T) {
+	type ruleUser struct {
+		Name string
+	}
+
+	testcases := []struct {
+		name      string
+		doc       string
+		types     []interface{}
+		wantError string
+	}{
+		{
+			name:      "invalid json",
+			doc:       `{"ruleUser": `,
+			wantError: "rules: unexpected EOF",
+		},
+		{
+			name:      "invalid document",
+			doc:       `{"ruleUser": ["required"]}`,
+			wantError: "rules: json: cannot unmarshal array into Go struct field ruleDocument.ruleUser of type map[string]string",
+		},
+		{
+			name:      "not struct",
+			doc:       `{}`,
+			types:     []interface{}{""},
+			wantError: "rules: struct type required",
+		},
+		{
+			name:      "unknown type",
+			doc:       `{"User": {"Email": "required"}}`,
+			types:     []interface{}{ruleAddress{}},
+			wantError: "rules: unknown type User",
+		},
+		{
+			name:      "ambiguous type",
+			doc:       `{"ruleUser": {"Email": "required"}}`,
+			types:     []interface{}{ruleUser{}, validatorTestRuleUser()},
+			wantError: "rules: ambiguous type ruleUser",
+		},
+		{
+			name:      "unknown field",
+			doc:       `{"ruleUser": {"Name": "required"}}`,
+			types:     []interface{}{validatorTestRuleUser()},
+			wantError: "rules: validator_test.ruleUser: field Name not found",
+		},
+		{
+			name:      "unknown nested field",
+			doc:       `{"ruleUser": {"Address.City": "required"}}`,
+			types:     []interface{}{validatorTestRuleUser()},
+			wantError: "rules: validator_test.ruleAddress: field City not found",
+		},
+		{
+			name:      "not struct field",
+			doc:       `{"ruleUser": {"Email.Domain": "required"}}`,
+			types:     []interface{}{validatorTestRuleUser()},
+			wantError: "rules: validator_test.ruleUser: field Email is not a struct",
+		},
+		{
+			name:      "conflicting paths",
+			doc:       `{"ruleUser": {"Address.Zip": "required,jp_zipcode", "Addresses.Zip": "-"}}`,
+			types:     []interface{}{validatorTestRuleUser()},
+			wantError: "rules: ruleUser.Addresses.Zip conflicts with ruleUser.Address.Zip: validator_test.ruleAddress.Zip has different rules",
+		},
+		{
+			name:      "conflicting types",
+			doc:       `{"ruleUser": {"Address.Zip": "required"}, "validator_test.ruleAddress": {"Zip": "jp_zipcode"}}`,
+			types:     []interface{}{validatorTestRuleUser(), ruleAddress{}},
+			wantError: "rules: validator_test.ruleAddress.Zip conflicts with ruleUser.Address.Zip: validator_test.ruleAddress.Zip has different rules",
+		},
+		{
+			name:      "unknown tag",
+			doc:       `{"ruleUser": {"Email": "required", "Age": "mx(150)"}}`,
+			types:     []interface{}{validatorTestRuleUser()},
+			wantError: "rules: validator_test.ruleUser.Age: parse: tag mx function not found",
+		},
+		{
+			name:      "invalid struct tag",
+			doc:       `{"ruleUser": {"Email": "required"}, "ruleWrong": {"Name": "required"}}`,
+			types:     []interface{}{validatorTestRuleUser(), ruleWrong{}},
+			wantError: "rules: validator_test.ruleWrong: parse: tag unknown function not found",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := validator.New()
+			err := v.LoadRules(strings.NewReader(tc.doc), tc.types...)
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+
+			// no rule is registered.
+			if err := v.ValidateStruct(&ruleUser{}); err != nil {
+				t.Errorf("want err nil, but got %v", err)
+			}
+			if err := v.ValidateStruct(validatorTestRuleUser()); err != nil {
+				t.Errorf("want err nil, but got %v", err)
+			}
+		})
+	}
+}
+
+func validatorTestRuleUser() *ruleUser {
+	return &ruleUser{}
+}
@@ -0,0 +1,126 @@
+package validator_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestRules(t *testing.T) {
+	type (
+		Item struct {
+			Name string
+		}
+
+		User struct {
+			Email   string
+			Age     int
+			Nick    string `valid:"required"`
+			Comment string `valid:"required"`
+			Items   []Item
+		}
+	)
+
+	v := validator.New()
+	err := v.Rules(&User{}).
+		Field("Email", "required,email").
+		Field("Age", "min(0),max(150)").
+		Field("Nick", "optional,alpha").
+		Field("Comment", "-").
+		Err()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Rules(Item{}).Field("Name", "required").Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	err = v.ValidateStruct(&User{Email: "gopher@example.com", Age: 10, Items: []Item{{Name: "a"}}})
+	if err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	err = v.ValidateStruct(&User{Email: "gopher", Age: 151, Nick: "123", Items: []Item{{}}})
+	assertValidationError(t, "Email: 'gopher' does validate as 'email';Age: '151' does validate as 'max(150)';Nick: '123' does validate as 'alpha';Items[0].Name: '' does validate as 'required'", err)
+
+	// the rules are not shared with other validators.
+	err = validator.New().ValidateStruct(&User{Email: "gopher"})
+	assertValidationError(t, "Nick: '' does validate as 'required';Comment: '' does validate as 'required'", err)
+}
+
+func TestRules_AfterCached(t *testing.T) {
+	type User struct {
+		Name string
+	}
+
+	v := validator.New()
+	if err := v.ValidateStruct(&User{}); err != nil {
+		t.Fatalf("want err nil, but got %v", err)
+	}
+
+	if err := v.Rules(&User{}).Field("Name", "required").Err(); err != nil {
+		t.Fatal(err)
+	}
+	assertValidationError(t, "Name: '' does validate as 'required'", v.ValidateStruct(&User{}))
+}
+
+func TestRules_InProgress(t *testing.T) {
+	type (
+		Item struct {
+			Name string `valid:"hook"`
+		}
+		List struct {
+			Items []Item
+		}
+	)
+
+	// the rule registered while validating does not affect the validation in progress.
+	var v *validator.Validator
+	var once sync.Once
+	v = validator.New(validator.WithFunc("hook", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		var err error
+		once.Do(func() {
+			err = v.Rules(&Item{}).Field("Name", "required").Err()
+		})
+		return err == nil, err
+	}))
+	if err := v.ValidateStruct(&List{Items: []Item{{}, {}}}); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+	assertValidationError(t, "Items[0].Name: '' does validate as 'required'", v.ValidateStruct(&List{Items: []Item{{}}}))
+}
+
+func TestRules_Invalid(t *testing.T) {
+	type User struct {
+		Name    string
+		private string
+	}
+
+	testcases := []struct {
+		name      string
+		b         *validator.RuleBuilder
+		wantError string
+	}{
+		{"not struct", validator.New().Rules(new(string)), "struct type required"},
+		{"nil", validator.New().Rules(nil), "struct type required"},
+		{"unknown field", validator.New().Rules(&User{}).Field("Email", "required"), "validator_test.User: field Email not found"},
+		{"private field", validator.New().Rules(&User{}).Field("private", "required"), "validator_test.User: field private not found"},
+		{"unknown tag", validator.New().Rules(&User{}).Field("Name", "requried"), "validator_test.User.Name: parse: tag requried function not found"},
+		{"unknown tag in or", validator.New().Rules(&User{}).Field("Name", "or(alpha|numbr)"), "validator_test.User.Name: parse: tag numbr function not found"},
+		{"first error", validator.New().Rules(&User{}).Field("Email", "required").Field("Name", "requried"), "validator_test.User: field Email not found"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.b.Err()
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+		})
+	}
+}
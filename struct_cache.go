@@ -41,3 +41,19 @@ func (c *structCache) Store(k reflect.Type, fields []fieldCache) {
 	m[k] = fields
 	c.v.Store(m)
 }
+
+// clone returns a copy of the cache that has the fields of the keys replaced.
+func (c *structCache) clone(replaced map[reflect.Type][]fieldCache) *structCache {
+	tmp := c.v.Load().(map[reflect.Type][]fieldCache)
+	m := make(map[reflect.Type][]fieldCache, len(tmp)+len(replaced))
+	for k, v := range tmp {
+		m[k] = v
+	}
+	for k, v := range replaced {
+		m[k] = v
+	}
+
+	nc := structCache{}
+	nc.v.Store(m)
+	return &nc
+}
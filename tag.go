@@ -18,6 +18,15 @@ type (
 
 		// validateFn is a validate function.
 		validateFn Func
+
+		// async is a flag. If true, the validate function is I/O-bound and runs concurrently after the traversal.
+		async bool
+
+		// scansRegex is a flag. If true, the built-in validate function scans the value by a regular expression.
+		scansRegex bool
+
+		// modifyFn is a modifying function. It is set in the mod tag.
+		modifyFn ModFunc
 	}
 
 	tagChunk struct {
@@ -31,6 +40,19 @@ type (
 	}
 )
 
+// optionalTagName is the name of the tag that makes the empty value always valid.
+const optionalTagName = "optional"
+
+// Name returns a tag name. e.g. len(1|2) -> "len"
+func (t Tag) Name() string {
+	return t.name
+}
+
+// Params returns tag parameters. e.g. len(1|2) -> []string{"1", "2"}
+func (t Tag) Params() []string {
+	return t.params
+}
+
 // Fullname returns a tag value.
 func (t Tag) Fullname() string {
 	if len(t.params) > 0 {
@@ -44,6 +66,22 @@ func (t Tag) String() string {
 	return t.Fullname()
 }
 
+// String returns a tag value of the chunk and the following chunks. e.g. optional,max(3);alpha
+func (c *tagChunk) String() string {
+	var levels []string
+	for ; c != nil; c = c.Next {
+		var tags []string
+		if c.Optional {
+			tags = append(tags, optionalTagName)
+		}
+		for _, tag := range c.Tags {
+			tags = append(tags, tag.Fullname())
+		}
+		levels = append(levels, strings.Join(tags, ","))
+	}
+	return strings.Join(levels, ";")
+}
+
 func (c *tagChunk) GetTags() []Tag {
 	if c == nil {
 		return nil
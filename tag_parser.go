@@ -2,11 +2,55 @@ package validator
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 )
 
+// ParseTag parses the tag and returns the tags for each level without validating any value.
+// The level 0 is for the field itself, and the level 1 is for its elements, and so on. e.g. `max(3);alpha`
+// The tags in the parameters of `or` tag are also parsed, and the tags are resolved by the functions of v,
+// so the functions set by WithFunc are known. It returns the same parse error as the validation.
+//
+// It is intended for the tools that check the tags statically, e.g. cmd/validatorlint, and to find invalid tags in advance.
+// The returned tags are copies, so modifying them does not affect v.
+func (v *Validator) ParseTag(rawTag string) ([][]Tag, error) {
+	cur := v.load()
+	chunk, err := cur.parseTag(rawTag)
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.compileChunk(chunk); err != nil {
+		return nil, err
+	}
+
+	var levels [][]Tag
+	for c := chunk; c != nil; c = c.Next {
+		tags := make([]Tag, 0, len(c.Tags))
+		for _, tag := range c.Tags {
+			tag.params = append([]string(nil), tag.params...)
+			tags = append(tags, tag)
+		}
+		levels = append(levels, tags)
+	}
+	return levels, nil
+}
+
+// parseTag parses the tag by the current configuration.
 func (v *Validator) parseTag(rawTag string) (*tagChunk, error) {
-	if tags, ok := v.tagCache.Load(rawTag); ok {
+	return v.load().parseTag(rawTag)
+}
+
+func (c *config) parseTag(rawTag string) (*tagChunk, error) {
+	return c.parseTagWith(rawTag, c.tagCache, c.newTag)
+}
+
+// parseModTag parses the modifying tag. e.g. `mod:"trim,lower"`
+func (c *config) parseModTag(rawTag string) (*tagChunk, error) {
+	return c.parseTagWith(rawTag, c.modTagCache, c.newModTag)
+}
+
+func (c *config) parseTagWith(rawTag string, cache *tagCache, newTag func(string) (Tag, error)) (*tagChunk, error) {
+	if tags, ok := cache.Load(rawTag); ok {
 		return tags, nil
 	}
 
@@ -15,7 +59,6 @@ func (v *Validator) parseTag(rawTag string) (*tagChunk, error) {
 		chunk     *tagChunk
 		orParsing = false
 	)
-	const optionalTagName = "optional"
 
 	chunk = &rootChunk
 
@@ -40,7 +83,7 @@ loop:
 				idx := len(chunk.Tags) - 1
 				chunk.Tags[idx].params = append(chunk.Tags[idx].params, lit)
 			} else {
-				tag, err := v.newTag(lit)
+				tag, err := newTag(lit)
 				if err != nil {
 					return nil, err
 				}
@@ -60,7 +103,7 @@ loop:
 				idx := len(chunk.Tags) - 1
 				chunk.Tags[idx].params = append(chunk.Tags[idx].params, lit)
 			} else {
-				tag, err := v.newTag(lit)
+				tag, err := newTag(lit)
 				if err != nil {
 					return nil, err
 				}
@@ -80,7 +123,12 @@ loop:
 				idx := len(chunk.Tags) - 1
 				chunk.Tags[idx].params = append(chunk.Tags[idx].params, lit)
 			} else {
-				chunk.Tags = append(chunk.Tags, Tag{name: "or", params: []string{lit}, validateFn: v.funcMap["or"]})
+				tag, err := newTag("or")
+				if err != nil {
+					return nil, err
+				}
+				tag.params = []string{lit}
+				chunk.Tags = append(chunk.Tags, tag)
 			}
 			orParsing = true
 
@@ -90,7 +138,7 @@ loop:
 					idx := len(chunk.Tags) - 1
 					chunk.Tags[idx].params = append(chunk.Tags[idx].params, lit)
 				} else {
-					tag, err := v.newTag(lit)
+					tag, err := newTag(lit)
 					if err != nil {
 						return nil, err
 					}
@@ -103,18 +151,55 @@ loop:
 		}
 	}
 
-	v.tagCache.Store(rawTag, &rootChunk)
+	cache.Store(rawTag, &rootChunk)
 
 	return &rootChunk, nil
 }
 
 // newTag returns Tag.
-func (v *Validator) newTag(lit string) (Tag, error) {
-	var (
-		name   string
-		params []string
-	)
+func (c *config) newTag(lit string) (Tag, error) {
+	name, params := splitTag(lit)
+
+	fn, ok := c.funcMap[name]
+	if !ok {
+		return Tag{}, fmt.Errorf("parse: tag %s function not found", name)
+	}
+
+	if check, ok := paramCheckers[reflect.ValueOf(c.baseFuncMap[name]).Pointer()]; ok {
+		if err := check(params); err != nil {
+			return Tag{}, fmt.Errorf("parse: tag %s: %v", name, err)
+		}
+	}
 
+	_, async := c.asyncFuncs[name]
+	return Tag{
+		name:       name,
+		params:     params,
+		validateFn: fn,
+		async:      async,
+		scansRegex: scansRegex(c.baseFuncMap[name]),
+	}, nil
+}
+
+// newModTag returns Tag that has a modifying function.
+func (c *config) newModTag(lit string) (Tag, error) {
+	name, params := splitTag(lit)
+
+	fn, ok := c.modFuncMap[name]
+	if !ok {
+		return Tag{}, fmt.Errorf("parse: mod %s function not found", name)
+	}
+
+	return Tag{
+		name:     name,
+		params:   params,
+		modifyFn: fn,
+	}, nil
+}
+
+// splitTag splits the literal into a tag name and parameters.
+// e.g. len(1|2) -> "len", []string{"1", "2"}
+func splitTag(lit string) (name string, params []string) {
 	idx := strings.Index(lit, "(")
 	if idx < 0 {
 		name = lit
@@ -134,15 +219,5 @@ func (v *Validator) newTag(lit string) (Tag, error) {
 			}
 		}
 	}
-
-	fn, ok := v.funcMap[name]
-	if !ok {
-		return Tag{}, fmt.Errorf("parse: tag %s function not found", name)
-	}
-
-	return Tag{
-		name:       name,
-		params:     params,
-		validateFn: fn,
-	}, nil
+	return name, params
 }
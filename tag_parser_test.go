@@ -2,6 +2,10 @@ package validator
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -483,7 +487,7 @@ func Test_tagCache(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	got, ok := v.tagCache.Load(rawTag)
+	got, ok := v.load().tagCache.Load(rawTag)
 	if !ok {
 		t.Fatal("want load true, got false")
 	}
@@ -516,3 +520,57 @@ func Test_tagCache(t *testing.T) {
 		}
 	}
 }
+
+func TestValidator_ParseTag(t *testing.T) {
+	levels, err := New().ParseTag("required,max(3);or(alpha|numeric),len(1|10)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, tags := range levels {
+		var names []string
+		for _, tag := range tags {
+			names = append(names, fmt.Sprintf("%s%v", tag.Name(), tag.Params()))
+		}
+		got = append(got, strings.Join(names, ","))
+	}
+	want := []string{"required[],max[3]", "or[alpha numeric],len[1 10]"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+
+	if _, err := New().ParseTag("or(alpha|numbr)"); err == nil || err.Error() != "parse: tag numbr function not found" {
+		t.Errorf("want parse error, but got %v", err)
+	}
+
+	// the functions set by the options are known, and the returned tags do not share the cache.
+	v := New(WithFunc("custom", hasValue))
+	levels, err = v.ParseTag("custom(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	levels[0][0].params[0] = "2"
+	levels, err = v.ParseTag("custom(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := "1", levels[0][0].Params()[0]; want != got {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func TestTagNames(t *testing.T) {
+	names := TagNames()
+	if len(names) != len(defaultFuncMap) {
+		t.Fatalf("want %d names, but got %d", len(defaultFuncMap), len(names))
+	}
+	if !sort.StringsAreSorted(names) {
+		t.Errorf("want sorted names, but got %v", names)
+	}
+	for _, name := range names {
+		if _, err := New().ParseTag(name); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
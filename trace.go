@@ -0,0 +1,149 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Trace represents a record of the validations, that explains which tags ran on each field and their results.
+	// Pass it to the validation with ContextWithTrace. It is safe for concurrent use, and records the validations in order
+	// of completion. It can be pretty-printed by String, or emitted as JSON by encoding/json.
+	Trace struct {
+		mu sync.Mutex
+
+		// Fields is a list of the visited fields in the order of the traversal.
+		Fields []*FieldTrace `json:"fields"`
+	}
+
+	// FieldTrace represents a record of a visited field.
+	FieldTrace struct {
+		// Field is a field name. e.g. Foo.Bar.Value
+		Field string `json:"field"`
+
+		// Tag is the resolved tag of the field. e.g. `max(3);alpha` for a slice, and `alpha` for its elements.
+		Tag string `json:"tag"`
+
+		// Optional is a flag. If true, the tag has optional.
+		Optional bool `json:"optional,omitempty"`
+
+		// Skipped is a flag. If true, the field is empty and the tags did not run because of optional.
+		Skipped bool `json:"skipped,omitempty"`
+
+		// Tags is a list of the tags that ran in order.
+		Tags []TagTrace `json:"tags"`
+	}
+
+	// TagTrace represents a record of a tag that ran.
+	TagTrace struct {
+		// Name is a tag name. e.g. len(1|2) -> "len"
+		Name string `json:"name"`
+
+		// Params is a tag parameters. e.g. len(1|2) -> []string{"1", "2"}
+		Params []string `json:"params,omitempty"`
+
+		// Valid is the result of the validating function.
+		Valid bool `json:"valid"`
+
+		// Err is the error message of the validating function.
+		Err string `json:"error,omitempty"`
+
+		// Async is a flag. If true, the tag is I/O-bound and ran after the traversal.
+		Async bool `json:"async,omitempty"`
+
+		// Duration is the time that the validating function took.
+		Duration time.Duration `json:"duration_ns"`
+	}
+
+	traceContextKey struct{}
+)
+
+// NewTrace returns a Trace.
+func NewTrace() *Trace {
+	return &Trace{}
+}
+
+// ContextWithTrace returns a context that records the validations to t.
+func ContextWithTrace(ctx context.Context, t *Trace) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, t)
+}
+
+// traceFromContext returns the Trace of the context, or nil.
+func traceFromContext(ctx context.Context) *Trace {
+	t, _ := ctx.Value(traceContextKey{}).(*Trace)
+	return t
+}
+
+// add appends the fields to the trace.
+func (t *Trace) add(fields []*FieldTrace) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.Fields = append(t.Fields, fields...)
+}
+
+// String returns a human-readable trace.
+func (t *Trace) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var b strings.Builder
+	for _, f := range t.Fields {
+		name := f.Field
+		if name == "" {
+			name = "(value)"
+		}
+		fmt.Fprintf(&b, "%s `%s`", name, f.Tag)
+		if f.Skipped {
+			b.WriteString(" skipped by optional")
+		}
+		b.WriteString("\n")
+
+		for _, tag := range f.Tags {
+			result := "valid"
+			if tag.Err != "" {
+				result = "error: " + tag.Err
+			} else if !tag.Valid {
+				result = "invalid"
+			}
+			async := ""
+			if tag.Async {
+				async = " async"
+			}
+			fmt.Fprintf(&b, "  %s%s: %s (%s)\n", Tag{name: tag.Name, params: tag.Params}.Fullname(), async, result, tag.Duration)
+		}
+	}
+	return b.String()
+}
+
+// newTagTrace returns a record of the tag that ran.
+func newTagTrace(tag Tag, valid bool, err error, d time.Duration) TagTrace {
+	t := TagTrace{Name: tag.name, Params: tag.params, Valid: valid, Duration: d}
+	if err != nil {
+		t.Err = err.Error()
+	}
+	return t
+}
+
+// traceField records the field that is going to be validated by the chunk, and returns the record.
+// It returns nil if the trace is disabled, or the field is validated tentatively.
+func (w *walker) traceField(field Field, chunk *tagChunk) *FieldTrace {
+	if w.trace == nil || w.tentative > 0 {
+		return nil
+	}
+	return w.addFieldTrace(field, chunk)
+}
+
+// addFieldTrace adds a record of the field to the trace. see traceField.
+func (w *walker) addFieldTrace(field Field, chunk *tagChunk) *FieldTrace {
+	f := &FieldTrace{
+		Field:    field.Name(),
+		Tag:      chunk.String(),
+		Optional: chunk.IsOptional(),
+		Tags:     []TagTrace{},
+	}
+	w.traceFields = append(w.traceFields, f)
+	return f
+}
@@ -0,0 +1,129 @@
+package validator_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestContextWithTrace(t *testing.T) {
+	type User struct {
+		Name     string   `valid:"required,alpha"`
+		Nickname string   `valid:"optional,min(3)"`
+		Tags     []string `valid:"max(2);or(alpha|numeric)"`
+	}
+
+	trace := validator.NewTrace()
+	ctx := validator.ContextWithTrace(context.Background(), trace)
+	err := validator.ValidateStructContext(ctx, &User{Name: "gopher", Tags: []string{"go", "-"}})
+	assertValidationError(t, "Tags[1]: '-' does validate as 'or(alpha|numeric)'", err)
+
+	type tagResult struct {
+		name  string
+		valid bool
+	}
+	expected := []struct {
+		field   string
+		tag     string
+		skipped bool
+		tags    []tagResult
+	}{
+		{field: "Name", tag: "required,alpha", tags: []tagResult{{"required", true}, {"alpha", true}}},
+		{field: "Nickname", tag: "optional,min(3)", skipped: true, tags: []tagResult{}},
+		{field: "Tags", tag: "max(2);or(alpha|numeric)", tags: []tagResult{{"max", true}}},
+		{field: "Tags[0]", tag: "or(alpha|numeric)", tags: []tagResult{{"or", true}}},
+		{field: "Tags[1]", tag: "or(alpha|numeric)", tags: []tagResult{{"or", false}}},
+	}
+	if len(trace.Fields) != len(expected) {
+		t.Fatalf("want %d fields, but got %d\n%s", len(expected), len(trace.Fields), trace)
+	}
+	for i, e := range expected {
+		f := trace.Fields[i]
+		if f.Field != e.field || f.Tag != e.tag || f.Skipped != e.skipped || len(f.Tags) != len(e.tags) {
+			t.Fatalf("want %+v, but got %+v", e, f)
+		}
+		for j, tag := range e.tags {
+			if f.Tags[j].Name != tag.name || f.Tags[j].Valid != tag.valid {
+				t.Errorf("%s: want %+v, but got %+v", f.Field, tag, f.Tags[j])
+			}
+		}
+	}
+
+	s := trace.String()
+	for _, want := range []string{
+		"Name `required,alpha`\n  required: valid (",
+		"Nickname `optional,min(3)` skipped by optional\n",
+		"Tags[1] `or(alpha|numeric)`\n  or(alpha|numeric): invalid (",
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("want %q in\n%s", want, s)
+		}
+	}
+
+	b, err := json.Marshal(trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Fields []struct {
+			Field   string `json:"field"`
+			Skipped bool   `json:"skipped"`
+			Tags    []struct {
+				Name     string   `json:"name"`
+				Params   []string `json:"params"`
+				Valid    bool     `json:"valid"`
+				Duration int64    `json:"duration_ns"`
+			} `json:"tags"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if f := decoded.Fields[4]; f.Field != "Tags[1]" || f.Tags[0].Name != "or" || strings.Join(f.Tags[0].Params, "|") != "alpha|numeric" || f.Tags[0].Valid {
+		t.Errorf("unexpected JSON %s", b)
+	}
+}
+
+func TestContextWithTrace_Async(t *testing.T) {
+	store := &userStore{names: map[string]bool{"alice": true}}
+	v := validator.New(validator.WithAsyncFunc("unique", store.notTaken))
+
+	trace := validator.NewTrace()
+	ctx := validator.ContextWithTrace(context.Background(), trace)
+	assertValidationError(t, ": 'alice' does validate as 'unique'", v.ValidateVarContext(ctx, "alice", "alpha,unique"))
+
+	if len(trace.Fields) != 1 || len(trace.Fields[0].Tags) != 2 {
+		t.Fatalf("unexpected trace\n%s", trace)
+	}
+	tag := trace.Fields[0].Tags[1]
+	if tag.Name != "unique" || !tag.Async || tag.Valid || tag.Duration == 0 {
+		t.Errorf("unexpected tag %+v", tag)
+	}
+}
+
+func TestContextWithTrace_Parallel(t *testing.T) {
+	values := make([]string, 500)
+	for i := range values {
+		values[i] = "gopher"
+	}
+
+	fields := func(v *validator.Validator) []string {
+		trace := validator.NewTrace()
+		if err := v.ValidateVarContext(validator.ContextWithTrace(context.Background(), trace), values, ";alpha"); err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, f := range trace.Fields {
+			names = append(names, f.Field)
+		}
+		return names
+	}
+
+	serial, parallel := fields(validator.New()), fields(validator.New(validator.WithParallelThreshold(100)))
+	if len(serial) != 501 || strings.Join(serial, ",") != strings.Join(parallel, ",") {
+		t.Errorf("want the same order as the serial validation, but got %v", parallel)
+	}
+}
@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// TypedValidator is a validator for the struct type T.
+	// All tags of T are parsed when it is created, so that invalid tags are found at startup.
+	TypedValidator[T any] struct {
+		v *Validator
+	}
+)
+
+// For returns a TypedValidator for the struct type T using default validator.
+func For[T any]() (*TypedValidator[T], error) {
+	return ForValidator[T](DefaultValidator())
+}
+
+// ForValidator returns a TypedValidator for the struct type T.
+// It registers T to v, and shares the tag and struct caches with v.
+func ForValidator[T any](v *Validator) (*TypedValidator[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("struct type required")
+	}
+
+	if err := v.Register(reflect.New(t).Interface()); err != nil {
+		return nil, err
+	}
+	return &TypedValidator[T]{v: v}, nil
+}
+
+// Validate validates a struct that uses the struct field's tag.
+// Pass context to each validating functions.
+func (tv *TypedValidator[T]) Validate(ctx context.Context, s *T) error {
+	if s == nil {
+		return nil
+	}
+	value := reflect.ValueOf(s)
+	c := tv.v.load()
+	w := c.newWalker(ctx, value)
+	if w.plain {
+		return c.validateStruct(ctx, w, Field{origin: value, current: value})
+	}
+
+	start := c.observeStart(ctx, s)
+	err := c.runAsync(ctx, w, c.validateStruct(ctx, w, Field{origin: value, current: value}))
+	w.release()
+	return c.observeEnd(ctx, s, start, err)
+}
@@ -0,0 +1,123 @@
+package validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+func TestFor(t *testing.T) {
+	type (
+		Address struct {
+			Country string `valid:"required,country"`
+		}
+
+		User struct {
+			Name      string     `valid:"required"`
+			ID        string     `valid:"or(alpha|numeric)"`
+			Addresses []*Address `valid:"max(2)"`
+		}
+	)
+
+	tv, err := validator.For[User]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tv.Validate(context.Background(), &User{Name: "gopher", ID: "abc", Addresses: []*Address{{Country: "JP"}}})
+	if err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	err = tv.Validate(context.Background(), &User{ID: "abc123", Addresses: []*Address{{Country: "jp"}}})
+	assertValidationError(t, "Name: '' does validate as 'required';ID: 'abc123' does validate as 'or(alpha|numeric)';Addresses[0].Country: 'jp' does validate as 'country'", err)
+
+	if err := tv.Validate(context.Background(), nil); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+}
+
+func TestFor_InvalidTag(t *testing.T) {
+	type (
+		Unknown struct {
+			Name string `valid:"requried"`
+		}
+
+		Nested struct {
+			Users map[string][]*Unknown
+		}
+
+		UnknownOr struct {
+			ID string `valid:"or(alpha|numbr)"`
+		}
+
+		Recursive struct {
+			Name     string `valid:"requried"`
+			Children []Recursive
+		}
+	)
+
+	testcases := []struct {
+		name      string
+		fn        func() error
+		wantError string
+	}{
+		{
+			name:      "unknown",
+			fn:        func() error { _, err := validator.For[Unknown](); return err },
+			wantError: "validator_test.Unknown.Name: parse: tag requried function not found",
+		},
+		{
+			name:      "nested",
+			fn:        func() error { _, err := validator.For[Nested](); return err },
+			wantError: "validator_test.Unknown.Name: parse: tag requried function not found",
+		},
+		{
+			name:      "unknown or",
+			fn:        func() error { _, err := validator.For[UnknownOr](); return err },
+			wantError: "validator_test.UnknownOr.ID: parse: tag numbr function not found",
+		},
+		{
+			name:      "recursive",
+			fn:        func() error { _, err := validator.For[Recursive](); return err },
+			wantError: "validator_test.Recursive.Name: parse: tag requried function not found",
+		},
+		{
+			name:      "not struct",
+			fn:        func() error { _, err := validator.For[string](); return err },
+			wantError: "struct type required",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fn()
+			if err == nil {
+				t.Fatal("want error, but got nil")
+			}
+			if err.Error() != tc.wantError {
+				t.Errorf("want `%v`, got `%v`", tc.wantError, err)
+			}
+		})
+	}
+}
+
+func TestForValidator(t *testing.T) {
+	type User struct {
+		Name string `tag_key_test:"required"`
+	}
+
+	v := validator.New(validator.WithTagKey("tag_key_test"))
+	tv, err := validator.ForValidator[User](v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tv.Validate(context.Background(), &User{})
+	assertValidationError(t, "Name: '' does validate as 'required'", err)
+
+	// shares the caches with the validator.
+	err = v.ValidateStruct(&User{})
+	assertValidationError(t, "Name: '' does validate as 'required'", err)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 var (
@@ -14,12 +16,38 @@ var (
 
 type (
 	// Validator is a validator that validates each fields using struct field's tag.
+	// It is safe for concurrent use, including Apply. Apply builds a new configuration with new caches and
+	// swaps it atomically, so the validations in progress keep using the previous configuration.
 	Validator struct {
-		// funcMap represents a map of validating functions.
+		// mux serializes the configuration changes.
+		mux *sync.Mutex
+
+		// current is the current configuration of type *config.
+		current *atomic.Value
+
+		// pending is the configuration that the options set while New, Apply and Clone build it. see build.
+		pending *config
+	}
+
+	// config is a configuration of Validator. It is not changed after it is stored to Validator, except for the caches.
+	config struct {
+		// funcMap represents a map of validating functions that the adapters are applied to. see applyAdapters.
 		funcMap FuncMap
 
-		// adapters represents a slice of validating function adapter.
-		adapters []Adapter
+		// baseFuncMap represents a map of validating functions that are set by the options.
+		baseFuncMap FuncMap
+
+		// asyncFuncs represents a set of the keys of the I/O-bound validating functions in funcMap.
+		asyncFuncs map[string]struct{}
+
+		// maxAsyncWorkers is a maximum number of the I/O-bound validating functions that run concurrently in a validation.
+		maxAsyncWorkers int
+
+		// adapters represents the validating function adapters of each option in the order of the registration.
+		adapters [][]TagAdapter
+
+		// funcAdapters represents the number of the adapter options registered before the function of the key. see applyAdapters.
+		funcAdapters map[string]int
 
 		// tagKey is the key in the struct field's tag. the default value is `valid`.
 		tagKey string
@@ -27,10 +55,51 @@ type (
 		// suppressErrorFieldValue is a flag that suppresses field value by error.
 		suppressErrorFieldValue bool
 
+		// modFuncMap represents a map of modifying functions.
+		modFuncMap ModFuncMap
+
+		// modTagKey is the key in the struct field's tag for modifying. the default value is `mod`.
+		modTagKey string
+
+		// defaultTagKey is the key in the struct field's tag for default values. the default value is `default`.
+		defaultTagKey string
+
+		// maxDepth is a maximum depth of the nested structs, slices, arrays and maps. if 0, it is unlimited.
+		maxDepth int
+
+		// maxElements is a maximum number of the elements visited in a traversal. if 0, it is unlimited.
+		maxElements int
+
+		// maxErrors is a maximum number of the errors in a validation. if 0, it is unlimited.
+		maxErrors int
+
+		// maxRegexBytes is a maximum number of the bytes scanned by the regular expressions in a validation. if 0, it is unlimited.
+		maxRegexBytes int
+
+		// observer observes the validations. if nil, it is disabled.
+		observer Observer
+
+		// parallelThreshold is the number of the elements of a slice, array or map to validate them concurrently. if 0, it is disabled.
+		parallelThreshold int
+
+		// stateless is a flag that no option needs the state of the traversal. see newWalker.
+		stateless bool
+
 		tagCache    *tagCache
+		modTagCache *tagCache
 		structCache *structCache
+
+		// modCache represents the field caches for modifying. see loadModCaches.
+		modCache *structCache
+
+		// defaultCache represents the field caches for setting defaults. see loadDefaultCaches.
+		defaultCache *structCache
+
+		// rules represents programmatic field rules per struct type. see Rules.
+		rules *ruleSet
 	}
 
+	// Option is a validator option. It sets the configuration that New, Apply and Clone build, not the current one.
 	Option func(v *Validator)
 )
 
@@ -38,24 +107,55 @@ type (
 func New(opts ...Option) *Validator {
 	funcMap := FuncMap{}
 	for k, fn := range defaultFuncMap {
-		funcMap[k] = apply(fn, defaultAdapters...)
+		funcMap[k] = fn
 	}
 
-	v := &Validator{
-		funcMap:     funcMap,
-		adapters:    defaultAdapters,
-		tagKey:      "valid",
-		tagCache:    newTagCache(),
-		structCache: newStructCache(),
+	modFuncMap := ModFuncMap{}
+	for k, fn := range defaultModFuncMap {
+		modFuncMap[k] = fn
 	}
-	v.Apply(opts...)
+
+	c := &config{
+		baseFuncMap:     funcMap,
+		asyncFuncs:      map[string]struct{}{},
+		funcAdapters:    map[string]int{},
+		maxAsyncWorkers: defaultMaxAsyncWorkers,
+		adapters:        [][]TagAdapter{tagAdapters(defaultAdapters)},
+		tagKey:          "valid",
+		modFuncMap:      modFuncMap,
+		modTagKey:       "mod",
+		defaultTagKey:   "default",
+		tagCache:        newTagCache(),
+		modTagCache:     newTagCache(),
+		structCache:     newStructCache(),
+		modCache:        newStructCache(),
+		defaultCache:    newStructCache(),
+		rules:           newRuleSet(),
+	}
+
+	v := &Validator{mux: &sync.Mutex{}, current: &atomic.Value{}}
+	v.build(c, opts)
 	return v
 }
 
+// build applies the options to the configuration and stores it as the current one. The caller must hold the lock if shared.
+func (v *Validator) build(c *config, opts []Option) {
+	v.pending = c
+	for _, o := range opts {
+		o(v)
+	}
+	v.pending = nil
+	c.applyAdapters()
+	c.stateless = c.maxDepth == 0 && c.maxElements == 0 && c.maxErrors == 0 && c.maxRegexBytes == 0 &&
+		c.observer == nil && len(c.asyncFuncs) == 0 && c.parallelThreshold == 0
+	v.current.Store(c)
+}
+
 // WithFunc is a validator option that sets a validating function.
 func WithFunc(k string, fn Func) Option {
 	return func(v *Validator) {
-		v.funcMap[k] = apply(fn, v.adapters...)
+		v.pending.setFunc(k, fn)
+		delete(v.pending.asyncFuncs, k)
 	}
 }
 
@@ -63,25 +163,54 @@ func WithFunc(k string, fn Func) Option {
 func WithFuncMap(funcMap FuncMap) Option {
 	return func(v *Validator) {
 		for k, fn := range funcMap {
-			v.funcMap[k] = apply(fn, v.adapters...)
+			v.pending.setFunc(k, fn)
+			delete(v.pending.asyncFuncs, k)
 		}
 	}
 }
 
-// WithAdapters is a validator option that sets validator function adapters.
+// WithAsyncFunc is a validator option that sets an I/O-bound validating function, e.g. a function that queries a database.
+// The function runs only if the other tags of the field pass, and runs concurrently with the other I/O-bound functions
+// after the traversal. The errors keep the order of the fields. It must be safe for concurrent use, and should return
+// when the context is done. In the parameters of or, it runs synchronously.
+func WithAsyncFunc(k string, fn Func) Option {
+	return func(v *Validator) {
+		v.pending.setFunc(k, fn)
+		v.pending.asyncFuncs[k] = struct{}{}
+	}
+}
+
+// WithMaxAsyncWorkers is a validator option that sets a maximum number of the I/O-bound validating functions
+// that run concurrently in a validation. The default value is 8. If n is 0 or less, it is unlimited.
+func WithMaxAsyncWorkers(n int) Option {
+	return func(v *Validator) {
+		v.pending.maxAsyncWorkers = n
+	}
+}
+
+// WithAdapters is a validator option that sets validator function adapters to all validating functions.
+// The adapters are applied left to right, that is the first adapter is the outermost and runs first.
+// Each WithAdapters and WithTagAdapters option wraps the functions set before it, that are adapted by the previous options,
+// so the adapters of the last option run first. The functions set after the options are adapted by all the adapters
+// in the order of the registration, that is the first registered adapter runs first.
 func WithAdapters(adapters ...Adapter) Option {
 	return func(v *Validator) {
-		v.adapters = append(v.adapters, adapters...)
-		for k, fn := range v.funcMap {
-			v.funcMap[k] = apply(fn, adapters...)
-		}
+		v.pending.adapters = append(v.pending.adapters, tagAdapters(adapters))
+	}
+}
+
+// WithTagAdapters is a validator option that sets validator function adapters that receive the tag name.
+// Use AdapterForTags and AdapterIf to apply adapters to specific tags. The order is the same as WithAdapters.
+func WithTagAdapters(adapters ...TagAdapter) Option {
+	return func(v *Validator) {
+		v.pending.adapters = append(v.pending.adapters, append([]TagAdapter(nil), adapters...))
 	}
 }
 
 // WithTagKey is a validator option that sets the key in the struct field's tag.
 func WithTagKey(k string) Option {
 	return func(v *Validator) {
-		v.tagKey = k
+		v.pending.tagKey = k
 	}
 }
 
@@ -89,15 +218,172 @@ func WithTagKey(k string) Option {
 // If enabled this option, the field value always replaces `The value`.
 func WithSuppressErrorFieldValue() Option {
 	return func(v *Validator) {
-		v.suppressErrorFieldValue = true
+		v.pending.suppressErrorFieldValue = true
+	}
+}
+
+// WithModFunc is a validator option that sets a modifying function.
+func WithModFunc(k string, fn ModFunc) Option {
+	return func(v *Validator) {
+		v.pending.modFuncMap[k] = fn
+	}
+}
+
+// WithModFuncMap is a validator option that sets modifying functions.
+func WithModFuncMap(modFuncMap ModFuncMap) Option {
+	return func(v *Validator) {
+		for k, fn := range modFuncMap {
+			v.pending.modFuncMap[k] = fn
+		}
+	}
+}
+
+// WithModTagKey is a validator option that sets the key in the struct field's tag for modifying.
+func WithModTagKey(k string) Option {
+	return func(v *Validator) {
+		v.pending.modTagKey = k
+	}
+}
+
+// WithDefaultTagKey is a validator option that sets the key in the struct field's tag for default values.
+func WithDefaultTagKey(k string) Option {
+	return func(v *Validator) {
+		v.pending.defaultTagKey = k
+	}
+}
+
+// WithMaxDepth is a validator option that sets a maximum depth of the nested structs, slices, arrays and maps.
+// If the depth exceeds it, the validation stops and returns LimitError. The default value is 0, that is unlimited.
+// Regardless of this option, the value that is already on the current path, that is a cycle, is not validated again.
+func WithMaxDepth(n int) Option {
+	return func(v *Validator) {
+		v.pending.maxDepth = n
+	}
+}
+
+// WithMaxElements is a validator option that sets a maximum total number of the elements of the slices, arrays and maps
+// visited in a validation, normalization or setting defaults. The length is checked before the elements are visited, so a huge collection is rejected
+// without iterating it. If the total exceeds it, the validation stops and returns LimitError.
+// The default value is 0, that is unlimited.
+func WithMaxElements(n int) Option {
+	return func(v *Validator) {
+		v.pending.maxElements = n
+	}
+}
+
+// WithMaxErrors is a validator option that sets a maximum number of the errors in a validation.
+// If the number exceeds it, the validation stops and returns LimitError instead of Errors.
+// The default value is 0, that is unlimited.
+func WithMaxErrors(n int) Option {
+	return func(v *Validator) {
+		v.pending.maxErrors = n
+	}
+}
+
+// WithMaxRegexBytes is a validator option that sets a maximum total number of the bytes of the strings scanned by
+// the built-in tags that use the regular expressions in a validation, e.g. email, alpha and uuid.
+// The functions registered by WithFunc are not counted even if they override the built-in tags.
+// The length is checked before the string is scanned. If the total exceeds it, the validation stops and returns LimitError.
+// The default value is 0, that is unlimited.
+func WithMaxRegexBytes(n int) Option {
+	return func(v *Validator) {
+		v.pending.maxRegexBytes = n
+	}
+}
+
+// WithParallelThreshold is a validator option that enables the parallel validation of the large slices, arrays and maps.
+// The elements of the outermost collection that has n or more elements are validated concurrently by GOMAXPROCS goroutines,
+// and the errors are merged in the order of the elements, so they are identical to the serial validation.
+// The validating functions must be safe for concurrent use. If the validation exceeds the limits,
+// the field of LimitError may differ from the serial validation. The default value is 0, that is disabled.
+func WithParallelThreshold(n int) Option {
+	return func(v *Validator) {
+		v.pending.parallelThreshold = n
 	}
 }
 
 // Apply applies validator options.
+// The caches are rebuilt, so the changed functions and adapters take effect on the tags that have already been parsed.
+// The struct types registered by Register should be registered again if necessary.
 func (v *Validator) Apply(opts ...Option) {
-	for _, o := range opts {
-		o(v)
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	v.build(v.load().copy(), opts)
+}
+
+// Clone returns a new validator that has the same configuration and rules as v, and applies the options to it.
+// The changes to the new validator do not affect v, and vice versa.
+func (v *Validator) Clone(opts ...Option) *Validator {
+	cur := v.load()
+	c := cur.copy()
+	c.rules = cur.rules.clone()
+	if len(opts) == 0 {
+		// the parsed tags can be shared because they depend on the same functions.
+		c.tagCache = cur.tagCache
+		c.modTagCache = cur.modTagCache
 	}
+
+	nv := &Validator{mux: &sync.Mutex{}, current: &atomic.Value{}}
+	nv.build(c, opts)
+	return nv
+}
+
+// setFunc sets the validating function, and records the adapter options registered before it. see applyAdapters.
+func (c *config) setFunc(k string, fn Func) {
+	c.baseFuncMap[k] = fn
+	c.funcAdapters[k] = len(c.adapters)
+}
+
+// applyAdapters builds funcMap by applying the adapters to the functions of baseFuncMap.
+// It is called after the options are applied.
+// The adapters registered before the function are applied at once, so the first one is the outermost of them,
+// and each option registered after the function wraps it again. see WithAdapters.
+func (c *config) applyAdapters() {
+	c.funcMap = make(FuncMap, len(c.baseFuncMap))
+	for k, fn := range c.baseFuncMap {
+		n := c.funcAdapters[k]
+		for i := n - 1; i >= 0; i-- {
+			fn = apply(k, fn, c.adapters[i]...)
+		}
+		for _, adapters := range c.adapters[n:] {
+			fn = apply(k, fn, adapters...)
+		}
+		c.funcMap[k] = fn
+	}
+}
+
+// load returns the current configuration.
+func (v *Validator) load() *config {
+	return v.current.Load().(*config)
+}
+
+// copy returns a copy of the configuration that has new caches. The rules are shared.
+func (c *config) copy() *config {
+	nc := *c
+	nc.baseFuncMap = make(FuncMap, len(c.baseFuncMap))
+	for k, fn := range c.baseFuncMap {
+		nc.baseFuncMap[k] = fn
+	}
+	nc.modFuncMap = make(ModFuncMap, len(c.modFuncMap))
+	for k, fn := range c.modFuncMap {
+		nc.modFuncMap[k] = fn
+	}
+	nc.funcAdapters = make(map[string]int, len(c.funcAdapters))
+	for k, n := range c.funcAdapters {
+		nc.funcAdapters[k] = n
+	}
+	nc.asyncFuncs = make(map[string]struct{}, len(c.asyncFuncs))
+	for k := range c.asyncFuncs {
+		nc.asyncFuncs[k] = struct{}{}
+	}
+	nc.adapters = append([][]TagAdapter(nil), c.adapters...)
+	nc.tagCache = newTagCache()
+	nc.modTagCache = newTagCache()
+	nc.structCache = newStructCache()
+	nc.modCache = newStructCache()
+	nc.defaultCache = newStructCache()
+	return &nc
 }
 
 // ValidateStruct validates a struct that uses the struct field's tag.
@@ -106,16 +392,25 @@ func (v *Validator) ValidateStruct(s interface{}) error {
 }
 
 // ValidateStructContext validates a struct that uses the struct field's tag.
-// Pass context to each validating functions.
+// Pass context to each validating functions. If ctx is done, the validation stops and returns CanceledError.
 func (v *Validator) ValidateStructContext(ctx context.Context, s interface{}) error {
 	if s == nil {
 		return nil
 	}
 	value := reflect.ValueOf(s)
-	return v.validateStruct(ctx, Field{origin: value, current: value})
+	c := v.load()
+	w := c.newWalker(ctx, value)
+	if w.plain {
+		return c.validateStruct(ctx, w, Field{origin: value, current: value})
+	}
+
+	start := c.observeStart(ctx, s)
+	err := c.runAsync(ctx, w, c.validateStruct(ctx, w, Field{origin: value, current: value}))
+	w.release()
+	return c.observeEnd(ctx, s, start, err)
 }
 
-func (v *Validator) validateStruct(ctx context.Context, field Field) error {
+func (c *config) validateStruct(ctx context.Context, w *walker, field Field) error {
 	val := field.current
 	if val.Kind() == reflect.Interface || val.Kind() == reflect.Ptr {
 		val = val.Elem()
@@ -125,41 +420,31 @@ func (v *Validator) validateStruct(ctx context.Context, field Field) error {
 		return fmt.Errorf("struct type required")
 	}
 
-	valueType := val.Type()
-	fieldCaches, hasCache := v.structCache.Load(valueType)
-	if !hasCache {
-		for i := 0; i < val.NumField(); i++ {
-			typeField := valueType.Field(i)
-			cache := fieldCache{
-				index:     i,
-				isPrivate: typeField.PkgPath != "", // private field
-				tagValue:  typeField.Tag.Get(v.tagKey),
-				name:      typeField.Name,
-			}
-			if cache.isPrivate {
-				continue
-			}
-			if !v.canValidate(cache.tagValue, v.extractVar(val.Field(i)).Kind()) {
-				continue
-			}
+	ref, ok, err := w.enter(Field{name: field.name, origin: field.origin, current: val})
+	if !ok {
+		return err
+	}
+	defer w.leave(ref)
 
-			chunk, err := v.parseTag(cache.tagValue)
-			if err != nil {
-				return err
-			}
-			cache.tagChunk = chunk
+	if err := w.tick(ctx, field); err != nil {
+		return err
+	}
 
-			fieldCaches = append(fieldCaches, cache)
-		}
-		v.structCache.Store(valueType, fieldCaches)
+	fieldCaches, err := c.loadFieldCaches(val)
+	if err != nil {
+		return err
 	}
 
 	var errs Errors
 	for i := 0; i < len(fieldCaches); i++ {
+		if fieldCaches[i].tagChunk == nil {
+			continue
+		}
+
 		originField := val.Field(fieldCaches[i].index)
-		valueField := v.extractVar(originField)
+		valueField := c.extractVar(originField)
 
-		if err := v.validate(ctx, newFieldWithParent(fieldCaches[i].name, originField, valueField, field), fieldCaches[i].tagChunk); err != nil {
+		if err := c.validate(ctx, w, newFieldWithParent(fieldCaches[i].name, originField, valueField, field), fieldCaches[i].tagChunk); err != nil {
 			if es, ok := err.(Errors); ok {
 				errs = append(errs, es...)
 			} else {
@@ -174,100 +459,268 @@ func (v *Validator) validateStruct(ctx context.Context, field Field) error {
 	return nil
 }
 
+// loadFieldCaches returns the field caches of the struct value.
+// If not cached, it parses the struct field's tags and stores them to the cache.
+func (c *config) loadFieldCaches(val reflect.Value) ([]fieldCache, error) {
+	valueType := val.Type()
+	fieldCaches, hasCache := c.structCache.Load(valueType)
+	if hasCache {
+		return fieldCaches, nil
+	}
+
+	fieldCaches, err := c.buildFieldCaches(val, c.rules.Load(valueType))
+	if err != nil {
+		return nil, err
+	}
+	c.structCache.Store(valueType, fieldCaches)
+
+	return fieldCaches, nil
+}
+
+// buildFieldCaches parses the struct field's tags of the struct value.
+// A rule in the rules takes precedence over the struct field's tag.
+func (c *config) buildFieldCaches(val reflect.Value, rules map[string]string) ([]fieldCache, error) {
+	valueType := val.Type()
+
+	var fieldCaches []fieldCache
+	for i := 0; i < val.NumField(); i++ {
+		typeField := valueType.Field(i)
+		cache := fieldCache{
+			index:     i,
+			isPrivate: typeField.PkgPath != "", // private field
+			tagValue:  typeField.Tag.Get(c.tagKey),
+			name:      typeField.Name,
+		}
+		if cache.isPrivate {
+			continue
+		}
+		if rawTag, ok := rules[typeField.Name]; ok {
+			cache.tagValue = rawTag
+		}
+
+		kind := c.extractVar(val.Field(i)).Kind()
+		if c.canValidate(cache.tagValue, kind) {
+			chunk, err := c.parseTag(cache.tagValue)
+			if err != nil {
+				return nil, err
+			}
+			cache.tagChunk = chunk
+		}
+		if cache.tagChunk == nil {
+			continue
+		}
+
+		fieldCaches = append(fieldCaches, cache)
+	}
+	return fieldCaches, nil
+}
+
 // ValidateVar validates a value.
 func (v *Validator) ValidateVar(s interface{}, rawTag string) error {
 	return v.ValidateVarContext(context.Background(), s, rawTag)
 }
 
 // ValidateVarContext validates a value.
-// Pass context to each validating functions.
+// Pass context to each validating functions. If ctx is done, the validation stops and returns CanceledError.
 func (v *Validator) ValidateVarContext(ctx context.Context, s interface{}, rawTag string) error {
 	value := reflect.ValueOf(s)
-	return v.validateVar(ctx, Field{origin: value, current: v.extractVar(value)}, rawTag)
+	c := v.load()
+	w := c.newWalker(ctx, value)
+	if w.plain {
+		return c.validateVar(ctx, w, Field{origin: value, current: c.extractVar(value)}, rawTag)
+	}
+
+	start := c.observeStart(ctx, s)
+	err := c.runAsync(ctx, w, c.validateVar(ctx, w, Field{origin: value, current: c.extractVar(value)}, rawTag))
+	w.release()
+	return c.observeEnd(ctx, s, start, err)
 }
 
-func (v *Validator) validateVar(ctx context.Context, field Field, rawTag string) error {
-	if !v.canValidate(rawTag, field.current.Kind()) {
+func (c *config) validateVar(ctx context.Context, w *walker, field Field, rawTag string) error {
+	if !c.canValidate(rawTag, field.current.Kind()) {
 		return nil
 	}
 
-	chunk, err := v.parseTag(rawTag)
+	chunk, err := c.parseTag(rawTag)
 	if err != nil {
 		return err
 	}
 
-	return v.validate(ctx, field, chunk)
+	return c.validate(ctx, w, field, chunk)
 }
 
-func (v *Validator) validate(ctx context.Context, field Field, chunk *tagChunk) error {
+func (c *config) validate(ctx context.Context, w *walker, field Field, chunk *tagChunk) error {
+	if err := w.tick(ctx, field); err != nil {
+		return err
+	}
+
+	ft := w.traceField(field, chunk)
 	if chunk.IsOptional() && isEmpty(field) {
+		if ft != nil {
+			ft.Skipped = true
+		}
 		return nil
 	}
 
-	var errs Errors
-	for _, tag := range chunk.GetTags() {
-		valid, err := tag.validateFn(ctx, field, FuncOption{TagParams: tag.params, v: v})
-		if !valid || err != nil {
-			errs = append(errs, &fieldError{
-				field:                   field,
-				tag:                     tag,
-				err:                     err,
-				suppressErrorFieldValue: v.suppressErrorFieldValue,
-			})
-		}
+	errs, err := c.validateTags(ctx, w, ft, field, chunk, nil)
+	if err != nil {
+		return err
 	}
 
 	var val = field.current
 	switch val.Kind() {
-	case reflect.Map:
-		for _, k := range val.MapKeys() {
-			value := val.MapIndex(k)
+	case reflect.Map, reflect.Slice, reflect.Array:
+		errs, err = c.validateElems(ctx, w, field, chunk, errs)
+		if err != nil {
+			return err
+		}
 
-			err := v.validate(ctx, newFieldWithParent(fmt.Sprintf("[%v]", k), value, v.extractVar(value), field), chunk.Next)
-			if err != nil {
-				if es, ok := err.(Errors); ok {
-					errs = append(errs, es...)
-				} else {
-					return err
-				}
+	case reflect.Struct:
+		err := c.validateStruct(ctx, w, newFieldWithParent("", field.origin, val, field))
+		if err != nil {
+			if es, ok := err.(Errors); ok {
+				errs = append(errs, es...)
+			} else {
+				return err
 			}
 		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateElems validates the elements of the map, slice or array of the field by the next chunk, and appends the errors to errs.
+func (c *config) validateElems(ctx context.Context, w *walker, field Field, chunk *tagChunk, errs Errors) (Errors, error) {
+	ref, ok, err := w.enter(field)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// the elements are already being validated on the current path.
+		return errs, nil
+	}
+	defer w.leave(ref)
+
+	val := field.current
+	if err := w.visit(field, val.Len()); err != nil {
+		return nil, err
+	}
+
+	if w.parallelThreshold > 0 && val.Len() >= w.parallelThreshold {
+		es, err := c.validateParallel(ctx, w, field, chunk.Next)
+		if err != nil {
+			return nil, err
+		}
+		return append(errs, es...), nil
+	}
 
-	case reflect.Slice, reflect.Array:
-		for i := 0; i < val.Len(); i++ {
-			value := val.Index(i)
+	if val.Kind() == reflect.Map {
+		for _, k := range sortedMapKeys(val) {
+			value := val.MapIndex(k)
 
-			err := v.validate(ctx, newFieldWithParent(fmt.Sprintf("[%d]", i), value, v.extractVar(value), field), chunk.Next)
+			err := c.validate(ctx, w, newFieldWithParent(fmt.Sprintf("[%v]", k), value, c.extractVar(value), field), chunk.Next)
 			if err != nil {
 				if es, ok := err.(Errors); ok {
 					errs = append(errs, es...)
 				} else {
-					return err
+					return nil, err
 				}
 			}
 		}
+		return errs, nil
+	}
 
-	case reflect.Interface, reflect.Ptr:
-		// do nothing
+	for i := 0; i < val.Len(); i++ {
+		value := val.Index(i)
 
-	case reflect.Struct:
-		err := v.validateStruct(ctx, newFieldWithParent("", field.origin, val, field))
+		err := c.validate(ctx, w, newFieldWithParent(fmt.Sprintf("[%d]", i), value, c.extractVar(value), field), chunk.Next)
 		if err != nil {
 			if es, ok := err.(Errors); ok {
 				errs = append(errs, es...)
 			} else {
-				return err
+				return nil, err
 			}
 		}
 	}
+	return errs, nil
+}
 
-	if len(errs) > 0 {
-		return errs
+// validateTags validates the field by the tags of the chunk, and appends the errors to errs.
+// It does not validate the elements and the nested struct of the field.
+// It returns LimitError if the validation exceeds the limits. If ft is not nil, the tags that run are recorded to it.
+func (c *config) validateTags(ctx context.Context, w *walker, ft *FieldTrace, field Field, chunk *tagChunk, errs Errors) (Errors, error) {
+	n := len(errs)
+	hasAsync := false
+	observer := w.observer
+	if w.tentative > 0 {
+		observer = nil
 	}
-	return nil
+	timed := ft != nil || observer != nil
+	tags := chunk.GetTags()
+	for i := range tags {
+		tag := &tags[i]
+		if tag.async && w.tentative == 0 {
+			hasAsync = true
+			continue
+		}
+
+		if tag.scansRegex && w.maxRegexBytes > 0 && field.current.Kind() == reflect.String {
+			if err := w.scan(field, field.current.Len()); err != nil {
+				return nil, err
+			}
+		}
+
+		var start time.Time
+		if timed {
+			start = time.Now()
+		}
+		valid, err := tag.validateFn(ctx, field, FuncOption{TagParams: tag.params, c: c, w: w})
+		if timed {
+			d := time.Since(start)
+			if ft != nil {
+				ft.Tags = append(ft.Tags, newTagTrace(*tag, valid, err, d))
+			}
+			if observer != nil {
+				observer.OnTag(ctx, field, *tag, valid, err, d)
+			}
+		}
+		switch err.(type) {
+		case *LimitError, *CanceledError:
+			// the parameters of or exceed the limits or are canceled.
+			return nil, err
+		}
+		if !valid || err != nil {
+			errs = append(errs, &fieldError{
+				field:                   field,
+				tag:                     *tag,
+				err:                     err,
+				suppressErrorFieldValue: c.suppressErrorFieldValue,
+			})
+			if err := w.report(field); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if hasAsync && len(errs) == n {
+		// the I/O-bound functions run after the traversal only if the other tags of the field pass. see runAsync.
+		for _, tag := range chunk.GetTags() {
+			if tag.async {
+				errs = w.postpone(errs, ft, &fieldError{
+					field:                   field,
+					tag:                     tag,
+					suppressErrorFieldValue: c.suppressErrorFieldValue,
+				})
+			}
+		}
+	}
+	return errs, nil
 }
 
-func (v *Validator) extractVar(in reflect.Value) reflect.Value {
+func (c *config) extractVar(in reflect.Value) reflect.Value {
 	val := in
 	for {
 		switch val.Kind() {
@@ -283,7 +736,7 @@ func (v *Validator) extractVar(in reflect.Value) reflect.Value {
 	}
 }
 
-func (v *Validator) canValidate(rawTag string, kind reflect.Kind) bool {
+func (c *config) canValidate(rawTag string, kind reflect.Kind) bool {
 	if rawTag == "-" {
 		return false
 	}
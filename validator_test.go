@@ -2,7 +2,11 @@ package validator_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/utahta/go-validator"
@@ -1036,6 +1040,30 @@ func TestValidateStruct_PrivateField(t *testing.T) {
 	}
 }
 
+func TestValidateStruct_NetworkTypes(t *testing.T) {
+	type (
+		Server struct {
+			IP      net.IP           `valid:"ipv4"`
+			Network *net.IPNet       `valid:"cidr"`
+			MAC     net.HardwareAddr `valid:"optional,mac"`
+			Port    int              `valid:"port"`
+		}
+	)
+
+	_, network, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = validator.ValidateStruct(Server{IP: net.ParseIP("10.0.0.1"), Network: network, Port: 80})
+	if err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	err = validator.ValidateStruct(Server{IP: net.ParseIP("::1"), Network: &net.IPNet{}, MAC: net.HardwareAddr{0x00}})
+	assertValidationError(t, "IP: '<Array>' does validate as 'ipv4';Network: 'IPNet' does validate as 'cidr';MAC: '<Array>' does validate as 'mac';Port: '0' does validate as 'port'", err)
+}
+
 func TestValidateStructContext(t *testing.T) {
 	type (
 		SimpleTest struct {
@@ -1137,6 +1165,98 @@ func TestWithAdapters(t *testing.T) {
 	}
 }
 
+func TestWithAdapters_Order(t *testing.T) {
+	var str string
+	adapter := func(s string) validator.Adapter {
+		return func(fn validator.Func) validator.Func {
+			return func(ctx context.Context, f validator.Field, o validator.FuncOption) (bool, error) {
+				str += s
+				return fn(ctx, f, o)
+			}
+		}
+	}
+	test := func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		str += "fn"
+		return true, nil
+	}
+
+	// the adapters registered before the function run in the order of the registration,
+	// and the adapters of each option registered after the function wrap it again.
+	for _, tc := range []struct {
+		opts []validator.Option
+		want string
+	}{
+		{[]validator.Option{validator.WithFunc("test", test), validator.WithAdapters(adapter("1")), validator.WithAdapters(adapter("2"), adapter("3"))}, "231fn"},
+		{[]validator.Option{validator.WithAdapters(adapter("1")), validator.WithFunc("test", test), validator.WithAdapters(adapter("2"), adapter("3"))}, "231fn"},
+		{[]validator.Option{validator.WithAdapters(adapter("1"), adapter("2")), validator.WithAdapters(adapter("3")), validator.WithFunc("test", test)}, "123fn"},
+		{[]validator.Option{validator.WithAdapters(adapter("1")), validator.WithAdapters(adapter("2")), validator.WithFunc("test", test), validator.WithAdapters(adapter("3"))}, "312fn"},
+	} {
+		str = ""
+		if err := validator.New(tc.opts...).ValidateVar("test", "test"); err != nil {
+			t.Fatal(err)
+		}
+		if str != tc.want {
+			t.Errorf("want %v, got %v", tc.want, str)
+		}
+	}
+
+	// the adapters of the later Apply wrap the functions adapted by the previous ones.
+	v := validator.New(validator.WithFunc("test", test), validator.WithAdapters(adapter("1")))
+	v.Apply(validator.WithAdapters(adapter("2")))
+	v.Apply(validator.WithTagAdapters(validator.AdapterForTags([]string{"test"}, adapter("3"), adapter("4"))))
+	str = ""
+	if err := v.ValidateVar("test", "test"); err != nil {
+		t.Fatal(err)
+	}
+	if str != "3421fn" {
+		t.Errorf("want 3421fn, got %v", str)
+	}
+
+	// the function set by the later Apply is adapted by all the adapters in the order of the registration.
+	v = validator.New(validator.WithAdapters(adapter("1")))
+	v.Apply(validator.WithAdapters(adapter("2")))
+	v.Apply(validator.WithFunc("test", test))
+	str = ""
+	if err := v.ValidateVar("test", "test"); err != nil {
+		t.Fatal(err)
+	}
+	if str != "12fn" {
+		t.Errorf("want 12fn, got %v", str)
+	}
+}
+
+func TestWithTagAdapters(t *testing.T) {
+	var names []string
+	v := validator.New(validator.WithTagAdapters(
+		func(name string, fn validator.Func) validator.Func {
+			return func(ctx context.Context, f validator.Field, o validator.FuncOption) (bool, error) {
+				names = append(names, name)
+				return fn(ctx, f, o)
+			}
+		},
+		validator.AdapterForTags([]string{"alpha"}, func(validator.Func) validator.Func {
+			return func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+				return true, nil
+			}
+		}),
+		validator.AdapterIf(func(name string) bool { return strings.HasPrefix(name, "uuid") }, func(fn validator.Func) validator.Func {
+			return func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+				return false, errors.New("unavailable")
+			}
+		}),
+	))
+
+	if err := v.ValidateVar("123", "required,alpha"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+	assertValidationError(t, ": '-' does validate as 'numeric'", v.ValidateVar("-", "alpha,numeric"))
+	assertValidationError(t, ": an internal error occurred in 'uuid4': unavailable", v.ValidateVar("-", "uuid4"))
+
+	if want, got := "required,alpha,alpha,numeric,uuid4", strings.Join(names, ","); want != got {
+		t.Errorf("want %s, but got %s", want, got)
+	}
+}
+
 func TestWithTagKey(t *testing.T) {
 	type (
 		TagKeyTest struct {
@@ -1169,6 +1289,129 @@ func TestWithSuppressErrorFieldValue(t *testing.T) {
 	}
 }
 
+func TestOption_Custom(t *testing.T) {
+	type User struct {
+		Name string `json:"name"`
+	}
+
+	// an option can be composed of the other options.
+	var withJSON validator.Option = func(v *validator.Validator) {
+		validator.WithTagKey("json")(v)
+		validator.WithFunc("name", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+			return false, nil
+		})(v)
+	}
+	assertValidationError(t, "Name: '' does validate as 'name'", validator.New(withJSON).ValidateStruct(&User{}))
+
+	v := validator.New()
+	v.Apply(withJSON)
+	assertValidationError(t, "Name: '' does validate as 'name'", v.ValidateStruct(&User{}))
+}
+
+func TestApply_AfterUse(t *testing.T) {
+	type User struct {
+		Name string `valid:"test"`
+	}
+
+	v := validator.New(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return false, nil
+	}))
+	assertValidationError(t, "Name: '' does validate as 'test'", v.ValidateStruct(&User{}))
+
+	v.Apply(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}))
+	if err := v.ValidateStruct(&User{}); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+
+	var called bool
+	v.Apply(validator.WithAdapters(func(fn validator.Func) validator.Func {
+		return func(ctx context.Context, f validator.Field, o validator.FuncOption) (bool, error) {
+			called = true
+			return fn(ctx, f, o)
+		}
+	}))
+	if err := v.ValidateStruct(&User{}); err != nil {
+		t.Errorf("want err nil, but got %v", err)
+	}
+	if !called {
+		t.Error("want adapter called")
+	}
+}
+
+func TestApply_KeepRules(t *testing.T) {
+	type User struct {
+		Name string
+	}
+
+	v := validator.New()
+	if err := v.Rules(&User{}).Field("Name", "required").Err(); err != nil {
+		t.Fatal(err)
+	}
+	v.Apply(validator.WithSuppressErrorFieldValue())
+	assertValidationError(t, "Name: The value does validate as 'required'", v.ValidateStruct(&User{}))
+}
+
+func TestApply_Concurrent(t *testing.T) {
+	type User struct {
+		Name string `valid:"required,test"`
+	}
+
+	v := validator.New(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := v.ValidateStruct(&User{Name: "gopher"}); err != nil {
+					t.Errorf("want err nil, but got %v", err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		v.Apply(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+			return true, nil
+		}))
+	}
+	wg.Wait()
+}
+
+func TestClone(t *testing.T) {
+	type User struct {
+		Name string
+		Nick string `valid:"test"`
+	}
+
+	v := validator.New(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}))
+	if err := v.Rules(&User{}).Field("Name", "required").Err(); err != nil {
+		t.Fatal(err)
+	}
+	assertValidationError(t, "Name: '' does validate as 'required'", v.ValidateStruct(&User{}))
+
+	c := v.Clone(validator.WithFunc("test", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return false, nil
+	}))
+	if err := c.Rules(&User{}).Field("Name", "-").Err(); err != nil {
+		t.Fatal(err)
+	}
+	assertValidationError(t, "Nick: '' does validate as 'test'", c.ValidateStruct(&User{}))
+
+	// the changes to the clone do not affect the original.
+	assertValidationError(t, "Name: '' does validate as 'required'", v.ValidateStruct(&User{}))
+
+	// the clone without options has the same configuration.
+	assertValidationError(t, "Name: '' does validate as 'required'", v.Clone().ValidateStruct(&User{}))
+}
+
 func assertValidationError(t *testing.T, expectMessage string, err error) {
 	if err == nil {
 		t.Fatal("err want `error`, but got `nil`")
@@ -0,0 +1,450 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// LimitError represents an error that occurs when the validation exceeds the limit.
+	LimitError struct {
+		// Field is a field name. e.g. Foo.Bar.Value
+		Field string
+
+		// Limit is a name of the limit. e.g. depth, elements, errors and regex bytes
+		Limit string
+
+		// Max is a maximum value of the limit.
+		Max int
+	}
+
+	// CanceledError represents an error that occurs when the context is canceled or its deadline is exceeded during the traversal.
+	// It wraps the error of the context, so errors.Is(err, context.Canceled) reports whether the context is canceled.
+	CanceledError struct {
+		// Field is a field name that is visited when the cancellation is detected. e.g. Foo.Bar.Value
+		Field string
+
+		// Err is the error of the context. e.g. context.Canceled and context.DeadlineExceeded
+		Err error
+	}
+
+	// walker represents the state of a traversal of a value.
+	walker struct {
+		// plain is a flag. If true, the walker counts and tracks nothing, so it is shared and never changed. see newWalker.
+		plain bool
+
+		// maxDepth is a maximum depth of the nested structs, slices, arrays and maps. if 0, it is unlimited.
+		maxDepth int
+
+		// depth is the number of the structs, slices, arrays and maps on the current path.
+		depth int
+
+		// path is a stack of the references of the values on the current path that may be referred again, that is a cycle.
+		path []reference
+
+		// pathSet is a set of the references on the current path. it is used instead of path when the path is long.
+		pathSet map[reference]struct{}
+
+		// maxElements is a maximum number of the elements of the slices, arrays and maps. if 0, it is unlimited.
+		maxElements int
+
+		// maxErrors is a maximum number of the validation errors. if 0, it is unlimited.
+		maxErrors int
+
+		// maxRegexBytes is a maximum number of the bytes scanned by the regular expressions. if 0, it is unlimited.
+		maxRegexBytes int
+
+		counts
+
+		// steps is the number of the values that have been visited. it is used to check the context at intervals.
+		steps int
+
+		// cancelable is a flag. If false, the context is never canceled, e.g. context.Background, so it is not checked.
+		cancelable bool
+
+		// async is a list of the validations by the I/O-bound functions that are postponed. see runAsync.
+		async []asyncCheck
+
+		// parallelThreshold is the number of the elements to validate them concurrently. if 0, it is disabled.
+		// it is 0 in the forked walkers, so only the outermost large collections are validated concurrently.
+		parallelThreshold int
+
+		// observer observes the validating functions. if nil, it is disabled.
+		observer Observer
+
+		// trace is the trace that records the validations. if nil, it is disabled.
+		trace *Trace
+
+		// traceFields is a list of the records of the visited fields. they are added to trace on release.
+		traceFields []*FieldTrace
+
+		// tentative is the depth of the validations whose errors may be discarded, e.g. the parameters of or.
+		// the errors are not reported while it is greater than 0.
+		tentative int
+	}
+
+	// counts represents the amounts that are limited in a traversal.
+	counts struct {
+		// elements is the number of the elements that have been visited.
+		elements int
+
+		// errors is the number of the validation errors that have been reported.
+		errors int
+
+		// regexBytes is the number of the bytes that have been scanned by the regular expressions.
+		regexBytes int
+	}
+
+	// reference represents the identity of a value that is referred by a pointer, a slice or a map.
+	reference struct {
+		ptr uintptr
+		typ reflect.Type
+	}
+
+	// cycleCache represents a cache of the types that may be on a cycle. see mayCycle.
+	cycleCache struct {
+		mux sync.Mutex
+		v   atomic.Value
+	}
+)
+
+// pathSetThreshold is the length of the path to start using pathSet.
+const pathSetThreshold = 32
+
+// ctxCheckInterval is the number of the visited values between the checks of the context.
+const ctxCheckInterval = 64
+
+// walkerPool is a pool of the walkers. A walker escapes to the heap because it is passed to the validating functions.
+var walkerPool = sync.Pool{
+	New: func() interface{} {
+		return &walker{}
+	},
+}
+
+// plainWalker is the walker that is shared by the traversals that need no state. see newWalker.
+var plainWalker = &walker{plain: true}
+
+// cycleTypes is a cache of the types that may be on a cycle.
+var cycleTypes = newCycleCache()
+
+// Error returns an error message string.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: exceeded the max %s %d", e.Field, e.Limit, e.Max)
+}
+
+// Error returns an error message string.
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("%s: validation canceled: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the error of the context.
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
+// newWalker returns a walker that has the limits of c and the trace of ctx for the traversal of the value.
+// Call release when the traversal is done. The validations release it without defer to save the cost,
+// since a walker that is not released by a panic is just not reused.
+// If c has no limits, observer, I/O-bound functions and parallel validation, ctx is never canceled nor traced,
+// and the value cannot be on a cycle, the traversal needs no state, so it returns the shared plain walker.
+// Then nothing is observed nor postponed, so ValidateStruct and ValidateVar skip observeStart, runAsync and release.
+func (c *config) newWalker(ctx context.Context, value reflect.Value) *walker {
+	if c.stateless && ctx.Done() == nil && traceFromContext(ctx) == nil && !mayCycle(value) {
+		return plainWalker
+	}
+
+	w := walkerPool.Get().(*walker)
+	w.trace = traceFromContext(ctx)
+	w.cancelable = ctx.Done() != nil
+	w.observer = c.observer
+	w.maxDepth = c.maxDepth
+	w.maxElements = c.maxElements
+	w.maxErrors = c.maxErrors
+	w.maxRegexBytes = c.maxRegexBytes
+	w.parallelThreshold = c.parallelThreshold
+	return w
+}
+
+// fork returns a walker that continues the traversal of w in another goroutine. Call join to merge the counts into w.
+func (w *walker) fork() *walker {
+	f := walkerPool.Get().(*walker)
+	f.maxDepth = w.maxDepth
+	f.depth = w.depth
+	f.path = append(f.path, w.path...)
+	if w.pathSet != nil {
+		f.pathSet = make(map[reference]struct{}, len(w.pathSet))
+		for r := range w.pathSet {
+			f.pathSet[r] = struct{}{}
+		}
+	}
+	f.maxElements = w.maxElements
+	f.maxErrors = w.maxErrors
+	f.maxRegexBytes = w.maxRegexBytes
+	f.counts = w.counts
+	f.cancelable = w.cancelable
+	f.tentative = w.tentative
+	// the records of f are merged in order on join, so they are not added to the trace on release.
+	f.trace = w.trace
+	f.observer = w.observer
+	return f
+}
+
+// join merges the counts and the postponed validations of the forked walker f into w.
+// base is the counts of w when f is forked.
+func (w *walker) join(f *walker, base counts) {
+	w.elements += f.elements - base.elements
+	w.errors += f.errors - base.errors
+	w.regexBytes += f.regexBytes - base.regexBytes
+	w.steps += f.steps
+	w.async = append(w.async, f.async...)
+	w.traceFields = append(w.traceFields, f.traceFields...)
+	f.traceFields = nil
+}
+
+// check returns LimitError if the counts exceed the limits. It is used after join.
+func (w *walker) check(field Field) error {
+	switch {
+	case w.maxElements > 0 && w.elements > w.maxElements:
+		return &LimitError{Field: field.Name(), Limit: "elements", Max: w.maxElements}
+	case w.maxErrors > 0 && w.errors > w.maxErrors:
+		return &LimitError{Field: field.Name(), Limit: "errors", Max: w.maxErrors}
+	case w.maxRegexBytes > 0 && w.regexBytes > w.maxRegexBytes:
+		return &LimitError{Field: field.Name(), Limit: "regex bytes", Max: w.maxRegexBytes}
+	}
+	return nil
+}
+
+// release adds the records of the fields to the trace, resets the walker and puts it back to the pool.
+// The walker must not be used after release.
+func (w *walker) release() {
+	if w.plain {
+		return
+	}
+	if w.trace != nil && len(w.traceFields) > 0 {
+		w.trace.add(w.traceFields)
+	}
+	*w = walker{path: w.path[:0]}
+	walkerPool.Put(w)
+}
+
+// enter enters the struct, slice, array or map of the field, and returns its reference. Call leave with it if it returns true.
+// It returns false if the value is already on the current path, that is a cycle, so the value should be skipped.
+// It returns LimitError if the depth exceeds the max depth.
+// Only the values that may be referred again are tracked on the path, so the others just count the depth.
+func (w *walker) enter(field Field) (reference, bool, error) {
+	if w.plain {
+		return reference{}, true, nil
+	}
+	if w.maxDepth > 0 && w.depth >= w.maxDepth {
+		return reference{}, false, &LimitError{Field: field.Name(), Limit: "depth", Max: w.maxDepth}
+	}
+
+	ref := referenceOf(field)
+	if ref.ptr == 0 {
+		w.depth++
+		return ref, true, nil
+	}
+	if w.pathSet != nil {
+		if _, ok := w.pathSet[ref]; ok {
+			return ref, false, nil
+		}
+	} else {
+		for _, r := range w.path {
+			if r == ref {
+				return ref, false, nil
+			}
+		}
+	}
+
+	w.depth++
+	w.path = append(w.path, ref)
+	if w.pathSet != nil {
+		w.pathSet[ref] = struct{}{}
+	} else if len(w.path) > pathSetThreshold {
+		w.pathSet = make(map[reference]struct{}, len(w.path))
+		for _, r := range w.path {
+			w.pathSet[r] = struct{}{}
+		}
+	}
+	return ref, true, nil
+}
+
+// leave leaves the value of ref that is entered last.
+func (w *walker) leave(ref reference) {
+	if w.plain {
+		return
+	}
+	w.depth--
+	if ref.ptr == 0 {
+		return
+	}
+	w.path = w.path[:len(w.path)-1]
+	if w.pathSet != nil {
+		delete(w.pathSet, ref)
+	}
+}
+
+// tick counts a visited value of the field, and checks the context at intervals, including the first value.
+// It returns CanceledError if the context is done. It does nothing if the context is never canceled.
+//
+// The checks that run for every value or every validation, e.g. tick, visit, report, traceField, runAsync and observeStart,
+// are kept small enough to be inlined and call another function only if the feature is enabled. see TestInline.
+func (w *walker) tick(ctx context.Context, field Field) error {
+	if !w.cancelable {
+		return nil
+	}
+	return w.checkContext(ctx, field)
+}
+
+// checkContext counts a visited value of the field, and checks the context at intervals. see tick.
+func (w *walker) checkContext(ctx context.Context, field Field) error {
+	n := w.steps
+	w.steps++
+	if n%ctxCheckInterval != 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return &CanceledError{Field: field.Name(), Err: err}
+	}
+	return nil
+}
+
+// visit counts n elements of the slice, array or map of the field.
+// It returns LimitError if the total number of the elements exceeds the max elements.
+// The elements are not counted if the max elements is not set.
+func (w *walker) visit(field Field, n int) error {
+	if w.maxElements == 0 {
+		return nil
+	}
+	w.elements += n
+	if w.elements > w.maxElements {
+		return newLimitError(field, "elements", w.maxElements)
+	}
+	return nil
+}
+
+// report counts a validation error of the field.
+// It returns LimitError if the total number of the errors exceeds the max errors.
+// The errors are not counted if the max errors is not set.
+func (w *walker) report(field Field) error {
+	if w.maxErrors == 0 || w.tentative > 0 {
+		return nil
+	}
+	w.errors++
+	if w.errors > w.maxErrors {
+		return newLimitError(field, "errors", w.maxErrors)
+	}
+	return nil
+}
+
+// scan counts n bytes of the field that are going to be scanned by a regular expression.
+// It returns LimitError if the total number of the bytes exceeds the max regex bytes.
+// The bytes are not counted if the max regex bytes is not set.
+func (w *walker) scan(field Field, n int) error {
+	if w.maxRegexBytes == 0 {
+		return nil
+	}
+	w.regexBytes += n
+	if w.regexBytes > w.maxRegexBytes {
+		return newLimitError(field, "regex bytes", w.maxRegexBytes)
+	}
+	return nil
+}
+
+// newLimitError returns LimitError of the field.
+func newLimitError(field Field, limit string, max int) error {
+	return &LimitError{Field: field.Name(), Limit: limit, Max: max}
+}
+
+// referenceOf returns the reference of the value of the field.
+// The zero reference is returned if the value cannot be on a cycle, that is neither a non-empty map or slice,
+// nor a struct or an array that is pointed by the field.
+func referenceOf(field Field) reference {
+	v := field.current
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		if v.Len() > 0 {
+			return reference{ptr: v.Pointer(), typ: v.Type()}
+		}
+	case reflect.Struct, reflect.Array:
+		if k := field.origin.Kind(); (k == reflect.Ptr || k == reflect.Interface) && v.CanAddr() {
+			return reference{ptr: v.UnsafeAddr(), typ: v.Type()}
+		}
+	}
+	return reference{}
+}
+
+// mayCycle returns true if the value may be on a cycle, that is its type refers to itself through the exported fields,
+// pointers, slices, arrays and maps, or has an interface whose dynamic value is unknown.
+func mayCycle(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	t := v.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		return cycleTypes.Load(t)
+	}
+	return false
+}
+
+func newCycleCache() *cycleCache {
+	c := cycleCache{}
+	c.v.Store(make(map[reflect.Type]bool))
+	return &c
+}
+
+// Load returns true if the type may be on a cycle. If not cached, it inspects the type and stores the result.
+func (c *cycleCache) Load(t reflect.Type) bool {
+	if cyclic, ok := c.v.Load().(map[reflect.Type]bool)[t]; ok {
+		return cyclic
+	}
+	cyclic := refersCycle(t, map[reflect.Type]struct{}{})
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	tmp := c.v.Load().(map[reflect.Type]bool)
+	m := make(map[reflect.Type]bool, len(tmp)+1)
+	for k, v := range tmp {
+		m[k] = v
+	}
+	m[t] = cyclic
+	c.v.Store(m)
+	return cyclic
+}
+
+// refersCycle returns true if the type refers to a type in visiting, or to an interface. see mayCycle.
+// The key types of maps and the unexported fields are not validated, so they are ignored.
+func refersCycle(t reflect.Type, visiting map[reflect.Type]struct{}) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+	default:
+		return false
+	}
+	if _, ok := visiting[t]; ok {
+		return true
+	}
+	visiting[t] = struct{}{}
+	defer delete(visiting, t)
+
+	if t.Kind() != reflect.Struct {
+		return refersCycle(t.Elem(), visiting)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" && refersCycle(f.Type, visiting) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/utahta/go-validator"
+)
+
+type node struct {
+	Value string `valid:"required" mod:"trim" default:"gopher"`
+	Next  *node
+	Prev  *node
+}
+
+func TestValidateStruct_Cycle(t *testing.T) {
+	a := &node{Value: "a"}
+	b := &node{Value: "b", Prev: a}
+	c := &node{Value: "", Prev: b}
+	a.Next, b.Next, c.Next = b, c, a
+	a.Prev = c
+
+	v := validator.New()
+	assertValidationError(t, "Next.Next.Value: '' does validate as 'required';Prev.Value: '' does validate as 'required'", v.ValidateStruct(a))
+
+	type graph struct {
+		Nodes map[string]*node `valid:"required"`
+		Self  []interface{}
+	}
+	g := &graph{Nodes: map[string]*node{"a": a}}
+	g.Self = []interface{}{g, g.Self}
+	assertValidationError(t, "Nodes[a].Next.Next.Value: '' does validate as 'required';Nodes[a].Prev.Value: '' does validate as 'required'", v.ValidateStruct(g))
+
+	// the cycle through a slice without pointers.
+	type tree struct {
+		Name     string `valid:"required"`
+		Children []tree
+	}
+	trees := make([]tree, 1)
+	trees[0].Children = trees
+	assertValidationError(t, "Name: '' does validate as 'required';Children[0].Name: '' does validate as 'required'", v.ValidateStruct(&trees[0]))
+}
+
+func TestValidateStruct_MaxDepth(t *testing.T) {
+	list := &node{Value: "a", Next: &node{Value: "b", Next: &node{Value: "c"}}}
+
+	if err := validator.New(validator.WithMaxDepth(3)).ValidateStruct(list); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+
+	err := validator.New(validator.WithMaxDepth(2)).ValidateStruct(list)
+	var limitErr *validator.LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("want LimitError, but got %v", err)
+	}
+	if limitErr.Limit != "depth" || limitErr.Max != 2 {
+		t.Errorf("want depth 2, but got %s %d", limitErr.Limit, limitErr.Max)
+	}
+	if want := "Next.Next: exceeded the max depth 2"; err.Error() != want {
+		t.Errorf("want %q, but got %q", want, err.Error())
+	}
+}
+
+func TestSetDefaults_Cycle(t *testing.T) {
+	a := &node{}
+	b := &node{Value: "b", Next: a}
+	a.Next = b
+
+	if err := validator.SetDefaults(a); err != nil {
+		t.Fatalf("want nil, but got %v", err)
+	}
+	if a.Value != "gopher" || b.Value != "b" {
+		t.Errorf("want gopher and b, but got %s and %s", a.Value, b.Value)
+	}
+}
+
+func TestNormalize_Cycle(t *testing.T) {
+	a := &node{Value: " a "}
+	b := &node{Value: " b ", Next: a}
+	a.Next = b
+
+	if err := validator.Normalize(a); err != nil {
+		t.Fatalf("want nil, but got %v", err)
+	}
+	if a.Value != "a" || b.Value != "b" {
+		t.Errorf("want a and b, but got %s and %s", a.Value, b.Value)
+	}
+}
+
+func TestValidateStruct_MaxElements(t *testing.T) {
+	type (
+		Item struct {
+			Tags []string `valid:"max(3);alpha"`
+		}
+		Order struct {
+			Items []Item
+			Notes map[string]string `valid:";alpha"`
+		}
+	)
+	order := &Order{
+		Items: []Item{{Tags: []string{"a", "b"}}, {Tags: []string{"c"}}},
+		Notes: map[string]string{"x": "y"},
+	}
+
+	if err := validator.New(validator.WithMaxElements(6)).ValidateStruct(order); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+
+	err := validator.New(validator.WithMaxElements(5)).ValidateStruct(order)
+	var limitErr *validator.LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("want LimitError, but got %v", err)
+	}
+	if want := "Notes: exceeded the max elements 5"; err.Error() != want {
+		t.Errorf("want %q, but got %q", want, err.Error())
+	}
+
+	err = validator.New(validator.WithMaxElements(1000)).ValidateStruct(&Item{Tags: make([]string, 100000)})
+	if want := "Tags: exceeded the max elements 1000"; err == nil || err.Error() != want {
+		t.Errorf("want %q, but got %v", want, err)
+	}
+
+	err = validator.New(validator.WithMaxElements(1)).Normalize(order)
+	if want := "Items: exceeded the max elements 1"; err == nil || err.Error() != want {
+		t.Errorf("want %q, but got %v", want, err)
+	}
+}
+
+func TestValidateStruct_MaxErrors(t *testing.T) {
+	type Form struct {
+		A string `valid:"required"`
+		B string `valid:"required"`
+		C string `valid:"or(alpha|numeric)"`
+		D string `valid:"required"`
+	}
+
+	v := validator.New(validator.WithMaxErrors(2))
+	assertValidationError(t, "A: '' does validate as 'required';B: '' does validate as 'required'", v.ValidateStruct(&Form{C: "a", D: "d"}))
+
+	// the errors of the parameters of or are not counted.
+	assertValidationError(t, "A: '' does validate as 'required';D: '' does validate as 'required'", v.ValidateStruct(&Form{B: "b", C: "1"}))
+
+	err := v.ValidateStruct(&Form{C: "-"})
+	var limitErr *validator.LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("want LimitError, but got %v", err)
+	}
+	if want := "C: exceeded the max errors 2"; err.Error() != want {
+		t.Errorf("want %q, but got %q", want, err.Error())
+	}
+}
+
+func TestValidateStruct_MaxRegexBytes(t *testing.T) {
+	type Form struct {
+		Name  string `valid:"alpha,max(10)"`
+		Email string `valid:"or(email|uuid)"`
+	}
+
+	v := validator.New(validator.WithMaxRegexBytes(32))
+	if err := v.ValidateStruct(&Form{Name: "gopher", Email: "gopher@example.com"}); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+
+	err := v.ValidateStruct(&Form{Name: "gopher", Email: "gopher+validator@example.com"})
+	var limitErr *validator.LimitError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("want LimitError, but got %v", err)
+	}
+	if want := "Email: exceeded the max regex bytes 32"; err.Error() != want {
+		t.Errorf("want %q, but got %q", want, err.Error())
+	}
+
+	// the max tag does not scan the value.
+	if err := v.ValidateVar(strings.Repeat("a", 64), "max(100)"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+
+	// a function registered by WithFunc is not counted even if it overrides a built-in tag.
+	v = validator.New(validator.WithMaxRegexBytes(32), validator.WithFunc("alpha", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		return true, nil
+	}))
+	if err := v.ValidateVar(strings.Repeat("a", 64), "alpha"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+}
+
+func TestValidateStructContext_Canceled(t *testing.T) {
+	type Form struct {
+		Values []string `valid:";count"`
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	v := validator.New(validator.WithFunc("count", func(context.Context, validator.Field, validator.FuncOption) (bool, error) {
+		calls++
+		if calls == 10 {
+			cancel()
+		}
+		return true, nil
+	}))
+
+	err := v.ValidateStructContext(ctx, &Form{Values: make([]string, 1000)})
+	var canceledErr *validator.CanceledError
+	if !errors.As(err, &canceledErr) {
+		t.Fatalf("want CanceledError, but got %v", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, but got %v", err)
+	}
+	if calls >= 1000 {
+		t.Errorf("want the traversal stopped, but got %d calls", calls)
+	}
+
+	// a canceled context is detected before the traversal.
+	calls = 0
+	if err := v.ValidateStructContext(ctx, &Form{Values: []string{"a"}}); !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, but got %v", err)
+	}
+	if err := v.NormalizeContext(ctx, &Form{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, but got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("want 0 calls, but got %d", calls)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	err = v.ValidateVarContext(ctx, []string{"a"}, ";count")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want context.DeadlineExceeded, but got %v", err)
+	}
+	if want := ": validation canceled: context deadline exceeded"; err == nil || err.Error() != want {
+		t.Errorf("want %q, but got %v", want, err)
+	}
+}
+
+func TestValidateStructContext_Or(t *testing.T) {
+	type key struct{}
+	v := validator.New(validator.WithFunc("ctx", func(ctx context.Context, _ validator.Field, _ validator.FuncOption) (bool, error) {
+		return ctx.Value(key{}) == "ok", nil
+	}))
+
+	ctx := context.WithValue(context.Background(), key{}, "ok")
+	if err := v.ValidateVarContext(ctx, "-", "or(alpha|ctx)"); err != nil {
+		t.Errorf("want nil, but got %v", err)
+	}
+	assertValidationError(t, ": '-' does validate as 'or(alpha|ctx)'", v.ValidateVar("-", "or(alpha|ctx)"))
+}
+
+func TestInline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the build in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	out, err := exec.Command(goBin, "build", "-gcflags=-m", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+
+	inlined := map[string]bool{}
+	for _, line := range strings.Split(string(out), "\n") {
+		if i := strings.Index(line, "can inline "); i >= 0 {
+			inlined[strings.Fields(line[i+len("can inline "):])[0]] = true
+		}
+	}
+	for _, name := range []string{
+		"(*walker).tick",
+		"(*walker).leave",
+		"(*walker).visit",
+		"(*walker).report",
+		"(*walker).scan",
+		"(*walker).traceField",
+		"(*config).runAsync",
+		"(*config).observeStart",
+		"(*config).observeEnd",
+	} {
+		if !inlined[name] {
+			t.Errorf("want %s inlined", name)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	// fullWidthTable represents East Asian Wide and Fullwidth characters.
+	fullWidthTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x1100, Hi: 0x115F, Stride: 1}, // Hangul Jamo
+			{Lo: 0x2E80, Hi: 0x303E, Stride: 1}, // CJK Radicals, Kangxi Radicals, CJK Symbols and Punctuation
+			{Lo: 0x3041, Hi: 0x33FF, Stride: 1}, // Hiragana, Katakana, Bopomofo, ..., CJK Compatibility
+			{Lo: 0x3400, Hi: 0x4DBF, Stride: 1}, // CJK Unified Ideographs Extension A
+			{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1}, // CJK Unified Ideographs
+			{Lo: 0xA000, Hi: 0xA4CF, Stride: 1}, // Yi Syllables, Yi Radicals
+			{Lo: 0xA960, Hi: 0xA97F, Stride: 1}, // Hangul Jamo Extended-A
+			{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1}, // Hangul Syllables
+			{Lo: 0xF900, Hi: 0xFAFF, Stride: 1}, // CJK Compatibility Ideographs
+			{Lo: 0xFE10, Hi: 0xFE19, Stride: 1}, // Vertical Forms
+			{Lo: 0xFE30, Hi: 0xFE6F, Stride: 1}, // CJK Compatibility Forms, Small Form Variants
+			{Lo: 0xFF01, Hi: 0xFF60, Stride: 1}, // Fullwidth Forms
+			{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1}, // Fullwidth Signs
+		},
+		R32: []unicode.Range32{
+			{Lo: 0x1F300, Hi: 0x1F64F, Stride: 1}, // Miscellaneous Symbols and Pictographs, Emoticons
+			{Lo: 0x1F900, Hi: 0x1F9FF, Stride: 1}, // Supplemental Symbols and Pictographs
+			{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1}, // CJK Unified Ideographs Extension B..F
+			{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1}, // CJK Unified Ideographs Extension G..
+		},
+	}
+
+	// halfWidthTable represents ASCII printable and Halfwidth characters.
+	halfWidthTable = &unicode.RangeTable{
+		R16: []unicode.Range16{
+			{Lo: 0x0020, Hi: 0x007E, Stride: 1}, // ASCII printable
+			{Lo: 0xFF61, Hi: 0xFF9F, Stride: 1}, // Halfwidth CJK Punctuation, Halfwidth Katakana
+			{Lo: 0xFFA0, Hi: 0xFFDC, Stride: 1}, // Halfwidth Hangul
+			{Lo: 0xFFE8, Hi: 0xFFEE, Stride: 1}, // Halfwidth Symbols
+		},
+		LatinOffset: 1,
+	}
+)
+
+// isAllIn returns true if s is not empty and all characters are in the table.
+func isAllIn(s string, table *unicode.RangeTable) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.Is(table, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsIn returns true if s contains any character in the table.
+func containsIn(s string, table *unicode.RangeTable) bool {
+	for _, r := range s {
+		if unicode.Is(table, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeSpaces returns s with the spaces removed if the tag parameters allow spaces. e.g. katakana(space)
+func removeSpaces(s string, spaces string, opt FuncOption) (string, error) {
+	for _, param := range opt.TagParams {
+		if param != "space" {
+			return "", fmt.Errorf("unknown param %s", param)
+		}
+		s = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(spaces, r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	return s, nil
+}